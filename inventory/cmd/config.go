@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
+)
+
+// Blob storage backends of attachments.
+const blobBackendLocal = "local"
+
+// Config of the inventory service, loaded by config.Loader.
+type Config struct {
+	GRPC struct {
+		Addr string `yaml:"addr" usage:"address the gRPC server listens on"`
+	} `yaml:"grpc"`
+	Gateway struct {
+		Addr string `yaml:"addr" usage:"address of HTTP/JSON gateway, disabled if empty"`
+	} `yaml:"gateway"`
+	Blob struct {
+		Backend string `yaml:"backend" usage:"blob storage of attachments, only local is supported"`
+		Dir     string `yaml:"dir" usage:"directory of the local blob storage for attachments"`
+	} `yaml:"blob"`
+	Seed struct {
+		Parts int `yaml:"parts" usage:"number of fake parts the catalog starts with"`
+	} `yaml:"seed"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"how long open calls are waited for on shutdown" reload:"true"`
+}
+
+func defaultConfig() *Config {
+	cfg := &Config{}
+	cfg.GRPC.Addr = "localhost:50052"
+	cfg.Blob.Backend = blobBackendLocal
+	cfg.Blob.Dir = "data/blobs"
+	cfg.Seed.Parts = 100
+	cfg.ShutdownTimeout = 10 * time.Second
+	return cfg
+}
+
+func (c *Config) Validate() error {
+	errs := []error{
+		config.ValidateAddr("grpc.addr", c.GRPC.Addr),
+		config.ValidatePositive("shutdown_timeout", c.ShutdownTimeout),
+	}
+	if c.Gateway.Addr != "" {
+		errs = append(errs, config.ValidateAddr("gateway.addr", c.Gateway.Addr))
+	}
+	if c.Blob.Backend != blobBackendLocal {
+		errs = append(errs, fmt.Errorf("unknown blob.backend %q, want %s", c.Blob.Backend, blobBackendLocal))
+	}
+	if c.Blob.Dir == "" {
+		errs = append(errs, errors.New("blob.dir must be set"))
+	}
+	if c.Seed.Parts < 0 {
+		errs = append(errs, fmt.Errorf("seed.parts must not be negative, got %d", c.Seed.Parts))
+	}
+	return errors.Join(errs...)
+}
@@ -1,26 +1,62 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	apiinventoryv1 "github.com/qyrlabs/test-backend/inventory/internal/api/inventory/v1"
+	blobRepository "github.com/qyrlabs/test-backend/inventory/internal/repository/blob"
 	partRepository "github.com/qyrlabs/test-backend/inventory/internal/repository/part"
+	schemaRepository "github.com/qyrlabs/test-backend/inventory/internal/repository/schema"
+	attachmentService "github.com/qyrlabs/test-backend/inventory/internal/service/attachment"
 	partService "github.com/qyrlabs/test-backend/inventory/internal/service/part"
+	schemaService "github.com/qyrlabs/test-backend/inventory/internal/service/schema"
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
+	"github.com/qyrlabs/test-backend/shared/pkg/gateway"
 	protoinventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
 )
 
-const grpcPort = 50052
-
 func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", grpcPort))
+	loader := config.NewLoader("INVENTORY", defaultConfig)
+	loader.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	cfg, err := loader.Load()
+	if err != nil {
+		log.Printf("failed to load config: %v\n", err)
+		return
+	}
+	effective, err := config.Format(cfg)
+	if err != nil {
+		log.Printf("%v\n", err)
+		return
+	}
+	if loader.PrintOnly() {
+		fmt.Print(effective)
+		return
+	}
+	log.Printf("effective config:\n%s", effective)
+
+	// Settings safe to change at runtime are read from current and reloaded on SIGHUP.
+	var current atomic.Pointer[Config]
+	current.Store(cfg)
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	defer stopReload()
+	go loader.Watch(reloadCtx, cfg, current.Store)
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
 		return
@@ -29,9 +65,18 @@ func main() {
 	grpcServer := grpc.NewServer()
 	reflection.Register(grpcServer)
 
-	repo := partRepository.NewRepository()
-	service := partService.NewService(repo)
-	api := apiinventoryv1.NewAPI(service)
+	blobRepo, err := blobRepository.NewLocalRepository(cfg.Blob.Dir)
+	if err != nil {
+		log.Printf("failed to init blob storage: %v\n", err)
+		return
+	}
+
+	repo := partRepository.NewRepository(cfg.Seed.Parts)
+	schemaRepo := schemaRepository.NewRepository()
+	service := partService.NewService(repo, schemaRepo)
+	schemas := schemaService.NewService(schemaRepo)
+	attachments := attachmentService.NewService(repo, blobRepo)
+	api := apiinventoryv1.NewAPI(service, schemas, attachments)
 
 	protoinventoryv1.RegisterInventoryServiceServer(grpcServer, api)
 
@@ -44,10 +89,37 @@ func main() {
 		}
 	}()
 
+	var gatewayServer *http.Server
+	if cfg.Gateway.Addr != "" {
+		gatewayServer, err = gateway.NewServer(context.Background(), cfg.Gateway.Addr, lis.Addr().String(), protoinventoryv1.RegisterInventoryServiceHandlerFromEndpoint)
+		if err != nil {
+			log.Printf("failed to create HTTP gateway: %v\n", err)
+			return
+		}
+
+		go func() {
+			log.Printf("HTTP gateway listening on %s\n", gatewayServer.Addr)
+			err := gatewayServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("failed to serve HTTP gateway: %v\n", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
+
+	if gatewayServer != nil {
+		log.Println("Shutting down HTTP gateway...")
+		ctx, cancel := context.WithTimeout(context.Background(), current.Load().ShutdownTimeout)
+		defer cancel()
+		if err := gatewayServer.Shutdown(ctx); err != nil {
+			log.Printf("failed to shutdown HTTP gateway: %v\n", err)
+		}
+	}
+
 	log.Println("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
@@ -8,11 +8,15 @@ import (
 type api struct {
 	inventoryv1.UnimplementedInventoryServiceServer
 
-	inventoryService service.PartService
+	inventoryService  service.PartService
+	schemaService     service.MetadataSchemaService
+	attachmentService service.AttachmentService
 }
 
-func NewAPI(inventoryService service.PartService) *api {
+func NewAPI(inventoryService service.PartService, schemaService service.MetadataSchemaService, attachmentService service.AttachmentService) *api {
 	return &api{
-		inventoryService: inventoryService,
+		inventoryService:  inventoryService,
+		schemaService:     schemaService,
+		attachmentService: attachmentService,
 	}
 }
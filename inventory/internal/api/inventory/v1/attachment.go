@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// downloadChunkSize is the size of content chunks sent to clients.
+const downloadChunkSize = 64 << 10
+
+// Uploads an attachment of a part.
+func (a *api) UploadAttachment(stream grpc.ClientStreamingServer[inventoryv1.UploadAttachmentRequest, inventoryv1.UploadAttachmentResponse]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive attachment info: %v", err)
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain attachment info")
+	}
+	if _, err := uuid.Parse(info.GetPartUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	attachment, err := a.attachmentService.Upload(stream.Context(), converter.ToModelAttachmentUpload(info), &chunkReader{stream: stream})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return status.Errorf(codes.NotFound, "part with uuid %s is not found", info.GetPartUuid())
+		case errors.Is(err, model.ErrAttachmentTooLarge),
+			errors.Is(err, model.ErrUnsupportedContentType),
+			errors.Is(err, model.ErrChecksumMismatch),
+			errors.Is(err, errUnexpectedInfo):
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("failed to upload attachment of part %s: %v", info.GetPartUuid(), err)
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	return stream.SendAndClose(&inventoryv1.UploadAttachmentResponse{
+		Attachment: converter.ToProtoAttachment(*attachment),
+	})
+}
+
+// Downloads an attachment or its thumbnail.
+func (a *api) DownloadAttachment(req *inventoryv1.DownloadAttachmentRequest, stream grpc.ServerStreamingServer[inventoryv1.DownloadAttachmentResponse]) error {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+	if _, err := uuid.Parse(req.GetAttachmentUuid()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	attachment, content, err := a.attachmentService.Download(stream.Context(), req.GetPartUuid(), req.GetAttachmentUuid(), req.GetThumbnail())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return status.Errorf(codes.NotFound, "part with uuid %s is not found", req.GetPartUuid())
+		case errors.Is(err, model.ErrAttachmentNotFound):
+			return status.Errorf(codes.NotFound, "attachment with uuid %s is not found", req.GetAttachmentUuid())
+		}
+		log.Printf("failed to download attachment %s: %v", req.GetAttachmentUuid(), err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	err = stream.Send(&inventoryv1.DownloadAttachmentResponse{
+		Data: &inventoryv1.DownloadAttachmentResponse_Attachment{Attachment: converter.ToProtoAttachment(*attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&inventoryv1.DownloadAttachmentResponse{
+				Data: &inventoryv1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			log.Printf("failed to read attachment %s: %v", req.GetAttachmentUuid(), err)
+			return status.Error(codes.Internal, "internal error")
+		}
+	}
+}
+
+var errUnexpectedInfo = errors.New("attachment info must be sent only once")
+
+// chunkReader reads attachment content from upload stream chunks.
+type chunkReader struct {
+	stream grpc.ClientStreamingServer[inventoryv1.UploadAttachmentRequest, inventoryv1.UploadAttachmentResponse]
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetInfo() != nil {
+			return 0, errUnexpectedInfo
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
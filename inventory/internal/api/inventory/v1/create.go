@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// Creates a part.
+func (a *api) CreatePart(ctx context.Context, req *inventoryv1.CreatePartRequest) (*inventoryv1.CreatePartResponse, error) {
+	if req.GetPart() == nil {
+		return nil, status.Error(codes.InvalidArgument, "part is required")
+	}
+
+	part, err := a.inventoryService.Create(ctx, converter.ToModelPartInfo(req.GetPart()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPart) || errors.Is(err, model.ErrInvalidMetadata) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("failed to create part: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.CreatePartResponse{
+		Part: converter.ToProtoPart(part),
+	}, nil
+}
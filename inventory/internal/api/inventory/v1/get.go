@@ -22,7 +22,7 @@ func (a *api) GetPart(ctx context.Context, req *inventoryv1.GetPartRequest) (*in
 		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
 	}
 
-	part, err := a.inventoryService.Get(ctx, req.GetUuid())
+	part, err := a.inventoryService.Get(ctx, req.GetUuid(), converter.ToModelUnitSystem(req.GetUnitSystem()))
 	if err != nil {
 		if errors.Is(err, model.ErrPartNotFound) {
 			return nil, status.Errorf(codes.NotFound, "part with uuid %s is not found", req.GetUuid())
@@ -2,19 +2,29 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
 	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
 )
 
 // Returns List of Parts by filter.
 func (a *api) ListParts(ctx context.Context, req *inventoryv1.ListPartsRequest) (*inventoryv1.ListPartsResponse, error) {
-	filteredParts, err := a.inventoryService.List(ctx, converter.ToProtoFilter(req.GetFilter()))
+	filteredParts, err := a.inventoryService.List(
+		ctx,
+		converter.ToProtoFilter(req.GetFilter()),
+		converter.ToModelPartsSort(req.GetSort()),
+		converter.ToModelUnitSystem(req.GetUnitSystem()),
+	)
 	if err != nil {
+		if errors.Is(err, model.ErrMetadataKeyNotNumeric) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		log.Printf("failed to list parts: %v", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// Defines metadata schema of a category.
+func (a *api) SetMetadataSchema(ctx context.Context, req *inventoryv1.SetMetadataSchemaRequest) (*inventoryv1.SetMetadataSchemaResponse, error) {
+	if req.GetSchema() == nil {
+		return nil, status.Error(codes.InvalidArgument, "schema is required")
+	}
+
+	schema, err := a.schemaService.Set(ctx, converter.ToModelMetadataSchema(req.GetSchema()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidMetadataSchema) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("failed to set metadata schema: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.SetMetadataSchemaResponse{
+		Schema: converter.ToProtoMetadataSchema(schema),
+	}, nil
+}
+
+// Returns metadata schema of a category.
+func (a *api) GetMetadataSchema(ctx context.Context, req *inventoryv1.GetMetadataSchemaRequest) (*inventoryv1.GetMetadataSchemaResponse, error) {
+	schema, err := a.schemaService.Get(ctx, converter.ToModelCategory(req.GetCategory()))
+	if err != nil {
+		if errors.Is(err, model.ErrMetadataSchemaNotFound) {
+			return nil, status.Errorf(codes.NotFound, "metadata schema of %s is not found", req.GetCategory())
+		}
+		log.Printf("failed to get metadata schema: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.GetMetadataSchemaResponse{
+		Schema: converter.ToProtoMetadataSchema(schema),
+	}, nil
+}
+
+// Returns metadata schemas of all categories.
+func (a *api) ListMetadataSchemas(ctx context.Context, _ *inventoryv1.ListMetadataSchemasRequest) (*inventoryv1.ListMetadataSchemasResponse, error) {
+	schemas, err := a.schemaService.List(ctx)
+	if err != nil {
+		log.Printf("failed to list metadata schemas: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.ListMetadataSchemasResponse{
+		Schemas: converter.ToProtoMetadataSchemas(schemas),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// Returns aggregated dimensions and weight of a set of parts for shipping.
+func (a *api) GetShippingInfo(ctx context.Context, req *inventoryv1.GetShippingInfoRequest) (*inventoryv1.GetShippingInfoResponse, error) {
+	if len(req.GetItems()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items must not be empty")
+	}
+	for _, item := range req.GetItems() {
+		if _, err := uuid.Parse(item.GetPartUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+		}
+		if item.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity of part %s must be positive", item.GetPartUuid())
+		}
+	}
+
+	info, err := a.inventoryService.ShippingInfo(ctx, converter.ToModelShippingItems(req.GetItems()), converter.ToModelUnitSystem(req.GetUnitSystem()))
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("failed to get shipping info: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.GetShippingInfoResponse{
+		ShippingInfo: converter.ToProtoShippingInfo(info),
+	}, nil
+}
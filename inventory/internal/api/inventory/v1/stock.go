@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// Atomically changes stock quantities of parts.
+func (a *api) AdjustStock(ctx context.Context, req *inventoryv1.AdjustStockRequest) (*inventoryv1.AdjustStockResponse, error) {
+	if len(req.GetAdjustments()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "adjustments must not be empty")
+	}
+	for _, adjustment := range req.GetAdjustments() {
+		if _, err := uuid.Parse(adjustment.GetPartUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+		}
+	}
+
+	levels, err := a.inventoryService.AdjustStock(ctx, converter.ToModelStockAdjustments(req.GetAdjustments()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Printf("failed to adjust stock: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.AdjustStockResponse{
+		Stock: converter.ToProtoStockLevels(levels),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+// Replaces part info.
+func (a *api) UpdatePart(ctx context.Context, req *inventoryv1.UpdatePartRequest) (*inventoryv1.UpdatePartResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+	if req.GetPart() == nil {
+		return nil, status.Error(codes.InvalidArgument, "part is required")
+	}
+
+	part, err := a.inventoryService.Update(ctx, req.GetUuid(), converter.ToModelPartInfo(req.GetPart()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with uuid %s is not found", req.GetUuid())
+		case errors.Is(err, model.ErrInvalidPart), errors.Is(err, model.ErrInvalidMetadata):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("failed to update part with uuid %s: %v", req.GetUuid(), err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryv1.UpdatePartResponse{
+		Part: converter.ToProtoPart(part),
+	}, nil
+}
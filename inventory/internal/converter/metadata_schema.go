@@ -0,0 +1,94 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoMetadataSchema(schema *model.MetadataSchema) *inventoryv1.MetadataSchema {
+	fields := make([]*inventoryv1.MetadataField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		allowedValues := make([]*inventoryv1.Value, 0, len(field.AllowedValues))
+		for _, value := range field.AllowedValues {
+			allowedValues = append(allowedValues, ToProtoValue(value))
+		}
+		fields = append(fields, &inventoryv1.MetadataField{
+			Key:           field.Key,
+			Kind:          ToProtoValueKind(field.Kind),
+			Required:      field.Required,
+			Unit:          field.Unit,
+			Min:           field.Min,
+			Max:           field.Max,
+			AllowedValues: allowedValues,
+			Description:   field.Description,
+		})
+	}
+
+	return &inventoryv1.MetadataSchema{
+		Category: ToProtoCategory(schema.Category),
+		Fields:   fields,
+	}
+}
+
+func ToProtoMetadataSchemas(schemas []*model.MetadataSchema) []*inventoryv1.MetadataSchema {
+	res := make([]*inventoryv1.MetadataSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		res = append(res, ToProtoMetadataSchema(schema))
+	}
+	return res
+}
+
+func ToModelMetadataSchema(schema *inventoryv1.MetadataSchema) model.MetadataSchema {
+	fields := make([]model.MetadataField, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		allowedValues := make([]*model.Value, 0, len(field.GetAllowedValues()))
+		for _, value := range field.GetAllowedValues() {
+			allowedValues = append(allowedValues, ToModelValue(value))
+		}
+		fields = append(fields, model.MetadataField{
+			Key:           field.GetKey(),
+			Kind:          ToModelValueKind(field.GetKind()),
+			Required:      field.GetRequired(),
+			Unit:          field.GetUnit(),
+			Min:           field.Min,
+			Max:           field.Max,
+			AllowedValues: allowedValues,
+			Description:   field.GetDescription(),
+		})
+	}
+
+	return model.MetadataSchema{
+		Category: ToModelCategory(schema.GetCategory()),
+		Fields:   fields,
+	}
+}
+
+func ToProtoValueKind(kind model.ValueKind) inventoryv1.ValueKind {
+	switch kind {
+	case model.ValueKindString:
+		return inventoryv1.ValueKind_VALUE_KIND_STRING
+	case model.ValueKindInt64:
+		return inventoryv1.ValueKind_VALUE_KIND_INT64
+	case model.ValueKindDouble:
+		return inventoryv1.ValueKind_VALUE_KIND_DOUBLE
+	case model.ValueKindBool:
+		return inventoryv1.ValueKind_VALUE_KIND_BOOL
+	default:
+		return inventoryv1.ValueKind_VALUE_KIND_UNSPECIFIED
+	}
+}
+
+func ToModelValueKind(kind inventoryv1.ValueKind) model.ValueKind {
+	switch kind {
+	case inventoryv1.ValueKind_VALUE_KIND_STRING:
+		return model.ValueKindString
+	case inventoryv1.ValueKind_VALUE_KIND_INT64:
+		return model.ValueKindInt64
+	case inventoryv1.ValueKind_VALUE_KIND_DOUBLE:
+		return model.ValueKindDouble
+	case inventoryv1.ValueKind_VALUE_KIND_BOOL:
+		return model.ValueKindBool
+	default:
+		return model.ValueKindUnspecified
+	}
+}
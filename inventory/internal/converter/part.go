@@ -21,6 +21,7 @@ func ToProtoPart(part *model.Part) *inventoryv1.Part {
 		Metadata:      ToProtoValueMap(part.Metadata),
 		CreatedAt:     timestamppb.New(*part.CreatedAt),
 		UpdatedAt:     timestamppb.New(*part.UpdatedAt),
+		Attachments:   ToProtoAttachments(part.Attachments),
 	}
 }
 
@@ -72,10 +73,70 @@ func ToProtoDimensions(dimensions *model.Dimensions) *inventoryv1.Dimensions {
 	}
 
 	return &inventoryv1.Dimensions{
-		Length: dimensions.Length,
-		Width:  dimensions.Width,
-		Height: dimensions.Height,
-		Weight: dimensions.Weight,
+		Length:           dimensions.Length,
+		Width:            dimensions.Width,
+		Height:           dimensions.Height,
+		Weight:           dimensions.Weight,
+		LengthUnit:       ToProtoLengthUnit(dimensions.LengthUnit),
+		WeightUnit:       ToProtoWeightUnit(dimensions.WeightUnit),
+		VolumetricWeight: dimensions.VolumetricWeight(),
+	}
+}
+
+func ToProtoLengthUnit(unit model.LengthUnit) inventoryv1.LengthUnit {
+	switch unit {
+	case model.LengthUnitCentimeter:
+		return inventoryv1.LengthUnit_LENGTH_UNIT_CENTIMETER
+	case model.LengthUnitInch:
+		return inventoryv1.LengthUnit_LENGTH_UNIT_INCH
+	default:
+		return inventoryv1.LengthUnit_LENGTH_UNIT_UNSPECIFIED
+	}
+}
+
+func ToProtoWeightUnit(unit model.WeightUnit) inventoryv1.WeightUnit {
+	switch unit {
+	case model.WeightUnitKilogram:
+		return inventoryv1.WeightUnit_WEIGHT_UNIT_KILOGRAM
+	case model.WeightUnitPound:
+		return inventoryv1.WeightUnit_WEIGHT_UNIT_POUND
+	default:
+		return inventoryv1.WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+	}
+}
+
+func ToModelUnitSystem(unitSystem inventoryv1.UnitSystem) model.UnitSystem {
+	switch unitSystem {
+	case inventoryv1.UnitSystem_UNIT_SYSTEM_METRIC:
+		return model.UnitSystemMetric
+	case inventoryv1.UnitSystem_UNIT_SYSTEM_IMPERIAL:
+		return model.UnitSystemImperial
+	default:
+		return model.UnitSystemUnspecified
+	}
+}
+
+func ToModelShippingItems(items []*inventoryv1.ShippingItem) []model.ShippingItem {
+	res := make([]model.ShippingItem, 0, len(items))
+	for _, item := range items {
+		res = append(res, model.ShippingItem{
+			PartUuid: item.GetPartUuid(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+	return res
+}
+
+func ToProtoShippingInfo(info *model.ShippingInfo) *inventoryv1.ShippingInfo {
+	return &inventoryv1.ShippingInfo{
+		TotalQuantity:         info.TotalQuantity,
+		TotalWeight:           info.TotalWeight,
+		TotalVolume:           info.TotalVolume,
+		TotalVolumetricWeight: info.TotalVolumetricWeight,
+		ChargeableWeight:      info.ChargeableWeight,
+		MaxLength:             info.MaxLength,
+		LengthUnit:            ToProtoLengthUnit(info.LengthUnit),
+		WeightUnit:            ToProtoWeightUnit(info.WeightUnit),
 	}
 }
 
@@ -143,9 +204,132 @@ func ToProtoFilter(filter *inventoryv1.PartsFilter) model.PartsFilter {
 		Categories:            categories,
 		ManufacturerCountries: copyPartsFilterField(filter.GetManufacturerCountries()),
 		Tags:                  copyPartsFilterField(filter.GetTags()),
+		MetadataRanges:        ToModelMetadataRanges(filter.GetMetadataRanges()),
+	}
+}
+
+func ToModelMetadataRanges(ranges []*inventoryv1.MetadataRange) []model.MetadataRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	res := make([]model.MetadataRange, 0, len(ranges))
+	for _, r := range ranges {
+		res = append(res, model.MetadataRange{
+			Key: r.GetKey(),
+			Min: r.Min,
+			Max: r.Max,
+		})
+	}
+	return res
+}
+
+func ToModelPartsSort(sort *inventoryv1.PartsSort) *model.PartsSort {
+	if sort == nil {
+		return nil
+	}
+	return &model.PartsSort{
+		MetadataKey: sort.GetMetadataKey(),
+		Descending:  sort.GetDescending(),
+	}
+}
+
+func ToModelPartInfo(info *inventoryv1.PartInfo) model.PartInfo {
+	return model.PartInfo{
+		Name:          info.GetName(),
+		Description:   info.GetDescription(),
+		PriceMinor:    info.GetPriceMinor(),
+		StockQuantity: info.GetStockQuantity(),
+		Category:      ToModelCategory(info.GetCategory()),
+		Dimensions:    ToModelDimensions(info.GetDimensions()),
+		Manufacturer:  ToModelManufacturer(info.GetManufacturer()),
+		Tags:          info.GetTags(),
+		Metadata:      ToModelValueMap(info.GetMetadata()),
+	}
+}
+
+func ToModelDimensions(dimensions *inventoryv1.Dimensions) *model.Dimensions {
+	if dimensions == nil {
+		return nil
+	}
+
+	return &model.Dimensions{
+		Length:     dimensions.GetLength(),
+		Width:      dimensions.GetWidth(),
+		Height:     dimensions.GetHeight(),
+		Weight:     dimensions.GetWeight(),
+		LengthUnit: ToModelLengthUnit(dimensions.GetLengthUnit()),
+		WeightUnit: ToModelWeightUnit(dimensions.GetWeightUnit()),
+	}
+}
+
+func ToModelLengthUnit(unit inventoryv1.LengthUnit) model.LengthUnit {
+	switch unit {
+	case inventoryv1.LengthUnit_LENGTH_UNIT_CENTIMETER:
+		return model.LengthUnitCentimeter
+	case inventoryv1.LengthUnit_LENGTH_UNIT_INCH:
+		return model.LengthUnitInch
+	default:
+		return model.LengthUnitUnspecified
+	}
+}
+
+func ToModelWeightUnit(unit inventoryv1.WeightUnit) model.WeightUnit {
+	switch unit {
+	case inventoryv1.WeightUnit_WEIGHT_UNIT_KILOGRAM:
+		return model.WeightUnitKilogram
+	case inventoryv1.WeightUnit_WEIGHT_UNIT_POUND:
+		return model.WeightUnitPound
+	default:
+		return model.WeightUnitUnspecified
+	}
+}
+
+func ToModelManufacturer(manufacturer *inventoryv1.Manufacturer) *model.Manufacturer {
+	if manufacturer == nil {
+		return nil
+	}
+
+	return &model.Manufacturer{
+		Name:    manufacturer.GetName(),
+		Country: manufacturer.GetCountry(),
+		Website: manufacturer.GetWebsite(),
 	}
 }
 
+func ToModelValueMap(metadata map[string]*inventoryv1.Value) map[string]*model.Value {
+	if metadata == nil {
+		return nil
+	}
+
+	res := make(map[string]*model.Value, len(metadata))
+	for key, value := range metadata {
+		res[key] = ToModelValue(value)
+	}
+
+	return res
+}
+
+func ToModelValue(value *inventoryv1.Value) *model.Value {
+	if value == nil {
+		return nil
+	}
+
+	modelValue := &model.Value{}
+
+	switch kind := value.GetKind().(type) {
+	case *inventoryv1.Value_StringValue:
+		modelValue.StringValue = &kind.StringValue
+	case *inventoryv1.Value_Int64Value:
+		modelValue.Int64Value = &kind.Int64Value
+	case *inventoryv1.Value_DoubleValue:
+		modelValue.DoubleValue = &kind.DoubleValue
+	case *inventoryv1.Value_BoolValue:
+		modelValue.BoolValue = &kind.BoolValue
+	}
+
+	return modelValue
+}
+
 func copyPartsFilterField(v []string) []string {
 	if len(v) == 0 {
 		return nil
@@ -154,3 +338,72 @@ func copyPartsFilterField(v []string) []string {
 	copy(res, v)
 	return res
 }
+
+func ToProtoAttachments(attachments []model.Attachment) []*inventoryv1.Attachment {
+	if attachments == nil {
+		return nil
+	}
+
+	res := make([]*inventoryv1.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		res = append(res, ToProtoAttachment(attachment))
+	}
+	return res
+}
+
+func ToProtoAttachment(attachment model.Attachment) *inventoryv1.Attachment {
+	protoAttachment := &inventoryv1.Attachment{
+		Uuid:           attachment.Uuid,
+		Filename:       attachment.Filename,
+		ContentType:    attachment.ContentType,
+		Kind:           ToProtoAttachmentKind(attachment.Kind),
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSha256: attachment.ChecksumSha256,
+		HasThumbnail:   attachment.HasThumbnail,
+	}
+	if attachment.CreatedAt != nil {
+		protoAttachment.CreatedAt = timestamppb.New(*attachment.CreatedAt)
+	}
+	return protoAttachment
+}
+
+func ToProtoAttachmentKind(kind model.AttachmentKind) inventoryv1.AttachmentKind {
+	switch kind {
+	case model.AttachmentKindImage:
+		return inventoryv1.AttachmentKind_ATTACHMENT_KIND_IMAGE
+	case model.AttachmentKindDocument:
+		return inventoryv1.AttachmentKind_ATTACHMENT_KIND_DOCUMENT
+	default:
+		return inventoryv1.AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+	}
+}
+
+func ToModelAttachmentUpload(info *inventoryv1.UploadAttachmentInfo) model.AttachmentUpload {
+	return model.AttachmentUpload{
+		PartUuid:       info.GetPartUuid(),
+		Filename:       info.GetFilename(),
+		ChecksumSha256: info.GetChecksumSha256(),
+	}
+}
+
+func ToModelStockAdjustments(adjustments []*inventoryv1.StockAdjustment) []model.StockAdjustment {
+	res := make([]model.StockAdjustment, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		res = append(res, model.StockAdjustment{
+			PartUuid: adjustment.GetPartUuid(),
+			Delta:    adjustment.GetDelta(),
+		})
+	}
+	return res
+}
+
+func ToProtoStockLevels(levels []model.StockLevel) []*inventoryv1.StockLevel {
+	res := make([]*inventoryv1.StockLevel, 0, len(levels))
+	for _, level := range levels {
+		res = append(res, &inventoryv1.StockLevel{
+			PartUuid:      level.PartUuid,
+			StockQuantity: level.StockQuantity,
+		})
+	}
+	return res
+}
@@ -2,4 +2,17 @@ package model
 
 import "errors"
 
-var ErrPartNotFound = errors.New("part not found")
+var (
+	ErrPartNotFound           = errors.New("part not found")
+	ErrInvalidPart            = errors.New("invalid part")
+	ErrInsufficientStock      = errors.New("insufficient stock")
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentTooLarge     = errors.New("attachment is too large")
+	ErrUnsupportedContentType = errors.New("unsupported attachment content type")
+	ErrChecksumMismatch       = errors.New("attachment checksum mismatch")
+	ErrBlobNotFound           = errors.New("blob not found")
+	ErrMetadataSchemaNotFound = errors.New("metadata schema not found")
+	ErrInvalidMetadataSchema  = errors.New("invalid metadata schema")
+	ErrInvalidMetadata        = errors.New("metadata does not match schema")
+	ErrMetadataKeyNotNumeric  = errors.New("metadata key is not declared numeric in schema")
+)
@@ -0,0 +1,102 @@
+package model
+
+// MetadataSchema declares metadata keys allowed for parts of a category.
+type MetadataSchema struct {
+	Category Category
+	Fields   []MetadataField
+}
+
+// Field returns the field declared for key.
+func (s *MetadataSchema) Field(key string) (MetadataField, bool) {
+	if s == nil {
+		return MetadataField{}, false
+	}
+	for _, field := range s.Fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return MetadataField{}, false
+}
+
+// MetadataField declares a metadata key.
+type MetadataField struct {
+	Key string
+	// Expected kind of the value.
+	Kind ValueKind
+	// Whether every part of the category must have the key.
+	Required bool
+	// Unit of numeric values.
+	Unit string
+	// Inclusive bounds of numeric values.
+	Min *float64
+	Max *float64
+	// Allowed values. Any value of the kind is allowed if empty.
+	AllowedValues []*Value
+	Description   string
+}
+
+// Kind of a metadata Value.
+type ValueKind int32
+
+const (
+	ValueKindUnspecified ValueKind = 0
+	ValueKindString      ValueKind = 1
+	ValueKindInt64       ValueKind = 2
+	ValueKindDouble      ValueKind = 3
+	ValueKindBool        ValueKind = 4
+)
+
+// IsNumeric reports whether values of the kind are numbers.
+func (k ValueKind) IsNumeric() bool {
+	return k == ValueKindInt64 || k == ValueKindDouble
+}
+
+// Kind returns kind of the set value.
+func (v *Value) Kind() ValueKind {
+	switch {
+	case v == nil:
+		return ValueKindUnspecified
+	case v.StringValue != nil:
+		return ValueKindString
+	case v.Int64Value != nil:
+		return ValueKindInt64
+	case v.DoubleValue != nil:
+		return ValueKindDouble
+	case v.BoolValue != nil:
+		return ValueKindBool
+	default:
+		return ValueKindUnspecified
+	}
+}
+
+// Number returns numeric value as float64.
+func (v *Value) Number() (float64, bool) {
+	switch v.Kind() {
+	case ValueKindInt64:
+		return float64(*v.Int64Value), true
+	case ValueKindDouble:
+		return *v.DoubleValue, true
+	default:
+		return 0, false
+	}
+}
+
+// Equal reports whether values have the same kind and value.
+func (v *Value) Equal(other *Value) bool {
+	if v.Kind() != other.Kind() {
+		return false
+	}
+	switch v.Kind() {
+	case ValueKindString:
+		return *v.StringValue == *other.StringValue
+	case ValueKindInt64:
+		return *v.Int64Value == *other.Int64Value
+	case ValueKindDouble:
+		return *v.DoubleValue == *other.DoubleValue
+	case ValueKindBool:
+		return *v.BoolValue == *other.BoolValue
+	default:
+		return true
+	}
+}
@@ -29,6 +29,8 @@ type Part struct {
 	CreatedAt *time.Time
 	// Last update timestamp.
 	UpdatedAt *time.Time
+	// Images and documents attached to the part.
+	Attachments []Attachment
 }
 
 // Category of the Part.
@@ -43,13 +45,61 @@ const (
 )
 
 // Dimenstions of the Part.
+// Length, Width and Height are measured in LengthUnit, Weight in WeightUnit.
 type Dimensions struct {
-	Length float64
-	Width  float64
-	Height float64
-	Weight float64
+	Length     float64
+	Width      float64
+	Height     float64
+	Weight     float64
+	LengthUnit LengthUnit
+	WeightUnit WeightUnit
 }
 
+// Unit of length.
+type LengthUnit int32
+
+const (
+	LengthUnitUnspecified LengthUnit = 0
+	LengthUnitCentimeter  LengthUnit = 1
+	LengthUnitInch        LengthUnit = 2
+)
+
+// Unit of weight.
+type WeightUnit int32
+
+const (
+	WeightUnitUnspecified WeightUnit = 0
+	WeightUnitKilogram    WeightUnit = 1
+	WeightUnitPound       WeightUnit = 2
+)
+
+// Attachment is an image or a document attached to the Part.
+type Attachment struct {
+	Uuid string
+	// Original file name.
+	Filename string
+	// Content type detected from the content.
+	ContentType string
+	Kind        AttachmentKind
+	// Content size in bytes.
+	SizeBytes int64
+	// Hex-encoded SHA-256 of the content.
+	ChecksumSha256 string
+	// Whether a PNG thumbnail is available.
+	HasThumbnail bool
+	// Upload timestamp.
+	CreatedAt *time.Time
+}
+
+// Kind of the Attachment.
+type AttachmentKind int32
+
+const (
+	AttachmentKindUnspecified AttachmentKind = 0
+	AttachmentKindImage       AttachmentKind = 1
+	AttachmentKindDocument    AttachmentKind = 2
+)
+
 // Manufacturer of the Part.
 type Manufacturer struct {
 	Name    string
@@ -71,4 +121,68 @@ type PartsFilter struct {
 	Categories            []Category
 	ManufacturerCountries []string
 	Tags                  []string
+	MetadataRanges        []MetadataRange
+}
+
+// Inclusive range of a numeric metadata value.
+type MetadataRange struct {
+	Key string
+	Min *float64
+	Max *float64
+}
+
+// Sorting of listed parts by numeric metadata value.
+type PartsSort struct {
+	MetadataKey string
+	Descending  bool
+}
+
+// PartInfo contains writable fields of a Part.
+type PartInfo struct {
+	Name          string
+	Description   string
+	PriceMinor    int64
+	StockQuantity int64
+	Category      Category
+	Dimensions    *Dimensions
+	Manufacturer  *Manufacturer
+	Tags          []string
+	Metadata      map[string]*Value
+}
+
+// Unit system used for dimensions.
+type UnitSystem int32
+
+const (
+	UnitSystemUnspecified UnitSystem = 0
+	// Centimeters and kilograms.
+	UnitSystemMetric UnitSystem = 1
+	// Inches and pounds.
+	UnitSystemImperial UnitSystem = 2
+)
+
+// ShippingItem is a part with quantity to ship.
+type ShippingItem struct {
+	PartUuid string
+	Quantity int64
+}
+
+// ShippingInfo aggregates dimensions and weight of a set of parts.
+type ShippingInfo struct {
+	TotalQuantity         int64
+	TotalWeight           float64
+	TotalVolume           float64
+	TotalVolumetricWeight float64
+	ChargeableWeight      float64
+	MaxLength             float64
+	LengthUnit            LengthUnit
+	WeightUnit            WeightUnit
+}
+
+// AttachmentUpload describes an attachment being uploaded.
+type AttachmentUpload struct {
+	PartUuid string
+	Filename string
+	// Expected hex-encoded SHA-256 of the content. Not checked if empty.
+	ChecksumSha256 string
 }
@@ -0,0 +1,14 @@
+package model
+
+// StockAdjustment is a change of a part stock quantity.
+type StockAdjustment struct {
+	PartUuid string
+	// Quantity to add, negative to take from stock.
+	Delta int64
+}
+
+// StockLevel is the stock quantity of a part.
+type StockLevel struct {
+	PartUuid      string
+	StockQuantity int64
+}
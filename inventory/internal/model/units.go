@@ -0,0 +1,99 @@
+package model
+
+const (
+	centimetersPerInch = 2.54
+	kilogramsPerPound  = 0.45359237
+
+	// volumetricDivisor is the courier divisor in cm³/kg used to compute
+	// volumetric weight from metric dimensions.
+	volumetricDivisor = 5000.0
+)
+
+// Units returns the length and weight units of the unit system.
+// Unspecified unit system falls back to metric.
+func (s UnitSystem) Units() (LengthUnit, WeightUnit) {
+	if s == UnitSystemImperial {
+		return LengthUnitInch, WeightUnitPound
+	}
+	return LengthUnitCentimeter, WeightUnitKilogram
+}
+
+// ToCentimeters converts length v measured in unit to centimeters.
+// Unspecified unit is treated as centimeters.
+func (u LengthUnit) ToCentimeters(v float64) float64 {
+	if u == LengthUnitInch {
+		return v * centimetersPerInch
+	}
+	return v
+}
+
+// FromCentimeters converts length v in centimeters to unit.
+func (u LengthUnit) FromCentimeters(v float64) float64 {
+	if u == LengthUnitInch {
+		return v / centimetersPerInch
+	}
+	return v
+}
+
+// ToKilograms converts weight v measured in unit to kilograms.
+// Unspecified unit is treated as kilograms.
+func (u WeightUnit) ToKilograms(v float64) float64 {
+	if u == WeightUnitPound {
+		return v * kilogramsPerPound
+	}
+	return v
+}
+
+// FromKilograms converts weight v in kilograms to unit.
+func (u WeightUnit) FromKilograms(v float64) float64 {
+	if u == WeightUnitPound {
+		return v / kilogramsPerPound
+	}
+	return v
+}
+
+// Convert returns a copy of dimensions expressed in the given unit system.
+func (d *Dimensions) Convert(system UnitSystem) *Dimensions {
+	if d == nil {
+		return nil
+	}
+	lengthUnit, weightUnit := system.Units()
+	convertLength := func(v float64) float64 {
+		return lengthUnit.FromCentimeters(d.LengthUnit.ToCentimeters(v))
+	}
+	return &Dimensions{
+		Length:     convertLength(d.Length),
+		Width:      convertLength(d.Width),
+		Height:     convertLength(d.Height),
+		Weight:     weightUnit.FromKilograms(d.WeightUnit.ToKilograms(d.Weight)),
+		LengthUnit: lengthUnit,
+		WeightUnit: weightUnit,
+	}
+}
+
+// Volume returns volume in cubic LengthUnit.
+func (d *Dimensions) Volume() float64 {
+	if d == nil {
+		return 0
+	}
+	return d.Length * d.Width * d.Height
+}
+
+// VolumetricWeight returns volumetric (dimensional) weight in WeightUnit.
+// It is computed from metric dimensions so the result does not depend
+// on the units dimensions are stored in.
+func (d *Dimensions) VolumetricWeight() float64 {
+	if d == nil {
+		return 0
+	}
+	metric := d.Convert(UnitSystemMetric)
+	return d.WeightUnit.FromKilograms(metric.Volume() / volumetricDivisor)
+}
+
+// MaxSide returns the longest of length, width and height in LengthUnit.
+func (d *Dimensions) MaxSide() float64 {
+	if d == nil {
+		return 0
+	}
+	return max(d.Length, d.Width, d.Height)
+}
@@ -0,0 +1,101 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	def "github.com/qyrlabs/test-backend/inventory/internal/repository"
+)
+
+var _ def.BlobRepository = &localRepository{}
+
+// localRepository stores blobs as files under the root directory.
+type localRepository struct {
+	root string
+}
+
+func NewLocalRepository(root string) (*localRepository, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &localRepository{
+		root: root,
+	}, nil
+}
+
+// Stores content under key. Content becomes visible only when fully written.
+func (r *localRepository) Put(ctx context.Context, key string, src io.Reader) (int64, error) {
+	path, err := r.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer func() {
+		// No-op after successful rename.
+		_ = os.Remove(tmp.Name())
+	}()
+
+	written, err := io.Copy(tmp, src)
+	if err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return written, nil
+}
+
+func (r *localRepository) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := r.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path) // #nosec G304 -- path is validated to stay under root
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, model.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return file, nil
+}
+
+func (r *localRepository) Delete(ctx context.Context, key string) error {
+	path, err := r.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// path maps key to a file path and rejects keys escaping the root directory.
+func (r *localRepository) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(key) || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(r.root, filepath.FromSlash(key)), nil
+}
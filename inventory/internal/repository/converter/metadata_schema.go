@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+func ToModelMetadataSchema(schema repomodel.MetadataSchema) *model.MetadataSchema {
+	fields := make([]model.MetadataField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		allowedValues := make([]*model.Value, 0, len(field.AllowedValues))
+		for _, value := range field.AllowedValues {
+			allowedValues = append(allowedValues, ToModelValue(value))
+		}
+		fields = append(fields, model.MetadataField{
+			Key:           field.Key,
+			Kind:          ToModelValueKind(field.Kind),
+			Required:      field.Required,
+			Unit:          field.Unit,
+			Min:           field.Min,
+			Max:           field.Max,
+			AllowedValues: allowedValues,
+			Description:   field.Description,
+		})
+	}
+	return &model.MetadataSchema{
+		Category: ToModelCategory(schema.Category),
+		Fields:   fields,
+	}
+}
+
+func ToRepoMetadataSchema(schema model.MetadataSchema) repomodel.MetadataSchema {
+	fields := make([]repomodel.MetadataField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		allowedValues := make([]*repomodel.Value, 0, len(field.AllowedValues))
+		for _, value := range field.AllowedValues {
+			allowedValues = append(allowedValues, ToRepoValue(value))
+		}
+		fields = append(fields, repomodel.MetadataField{
+			Key:           field.Key,
+			Kind:          ToRepoValueKind(field.Kind),
+			Required:      field.Required,
+			Unit:          field.Unit,
+			Min:           field.Min,
+			Max:           field.Max,
+			AllowedValues: allowedValues,
+			Description:   field.Description,
+		})
+	}
+	return repomodel.MetadataSchema{
+		Category: ToRepoCategory(schema.Category),
+		Fields:   fields,
+	}
+}
+
+func ToModelValueKind(kind repomodel.ValueKind) model.ValueKind {
+	switch kind {
+	case repomodel.ValueKindString:
+		return model.ValueKindString
+	case repomodel.ValueKindInt64:
+		return model.ValueKindInt64
+	case repomodel.ValueKindDouble:
+		return model.ValueKindDouble
+	case repomodel.ValueKindBool:
+		return model.ValueKindBool
+	default:
+		return model.ValueKindUnspecified
+	}
+}
+
+func ToRepoValueKind(kind model.ValueKind) repomodel.ValueKind {
+	switch kind {
+	case model.ValueKindString:
+		return repomodel.ValueKindString
+	case model.ValueKindInt64:
+		return repomodel.ValueKindInt64
+	case model.ValueKindDouble:
+		return repomodel.ValueKindDouble
+	case model.ValueKindBool:
+		return repomodel.ValueKindBool
+	default:
+		return repomodel.ValueKindUnspecified
+	}
+}
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"slices"
+
 	"github.com/qyrlabs/test-backend/inventory/internal/model"
 	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
 )
@@ -15,10 +17,11 @@ func ToModelPart(part repomodel.Part) *model.Part {
 		Category:      ToModelCategory(part.Category),
 		Dimensions:    ToModelDimensions(part.Dimensions),
 		Manufacturer:  ToModelManufacturer(part.Manufacturer),
-		Tags:          part.Tags,
+		Tags:          slices.Clone(part.Tags),
 		Metadata:      ToModelValueMap(part.Metadata),
 		CreatedAt:     part.CreatedAt,
 		UpdatedAt:     part.UpdatedAt,
+		Attachments:   ToModelAttachments(part.Attachments),
 	}
 }
 
@@ -44,10 +47,34 @@ func ToModelDimensions(dimensions *repomodel.Dimensions) *model.Dimensions {
 		return nil
 	}
 	return &model.Dimensions{
-		Length: dimensions.Length,
-		Width:  dimensions.Width,
-		Height: dimensions.Height,
-		Weight: dimensions.Weight,
+		Length:     dimensions.Length,
+		Width:      dimensions.Width,
+		Height:     dimensions.Height,
+		Weight:     dimensions.Weight,
+		LengthUnit: ToModelLengthUnit(dimensions.LengthUnit),
+		WeightUnit: ToModelWeightUnit(dimensions.WeightUnit),
+	}
+}
+
+func ToModelLengthUnit(unit repomodel.LengthUnit) model.LengthUnit {
+	switch unit {
+	case repomodel.LengthUnitCentimeter:
+		return model.LengthUnitCentimeter
+	case repomodel.LengthUnitInch:
+		return model.LengthUnitInch
+	default:
+		return model.LengthUnitUnspecified
+	}
+}
+
+func ToModelWeightUnit(unit repomodel.WeightUnit) model.WeightUnit {
+	switch unit {
+	case repomodel.WeightUnitKilogram:
+		return model.WeightUnitKilogram
+	case repomodel.WeightUnitPound:
+		return model.WeightUnitPound
+	default:
+		return model.WeightUnitUnspecified
 	}
 }
 
@@ -73,6 +100,110 @@ func ToModelValueMap(metadata map[string]*repomodel.Value) map[string]*model.Val
 	return result
 }
 
+func ToRepoPart(part *model.Part) repomodel.Part {
+	return repomodel.Part{
+		Uuid:          part.Uuid,
+		Name:          part.Name,
+		Description:   part.Description,
+		PriceMinor:    part.PriceMinor,
+		StockQuantity: part.StockQuantity,
+		Category:      ToRepoCategory(part.Category),
+		Dimensions:    ToRepoDimensions(part.Dimensions),
+		Manufacturer:  ToRepoManufacturer(part.Manufacturer),
+		Tags:          slices.Clone(part.Tags),
+		Metadata:      ToRepoValueMap(part.Metadata),
+		CreatedAt:     part.CreatedAt,
+		UpdatedAt:     part.UpdatedAt,
+	}
+}
+
+func ToRepoCategory(category model.Category) repomodel.Category {
+	switch category {
+	case model.CategoryUnspecified:
+		return repomodel.CategoryUnspecified
+	case model.CategoryEngine:
+		return repomodel.CategoryEngine
+	case model.CategoryFuel:
+		return repomodel.CategoryFuel
+	case model.CategoryPorthole:
+		return repomodel.CategoryPorthole
+	case model.CategoryWing:
+		return repomodel.CategoryWing
+	default:
+		return repomodel.CategoryUnspecified
+	}
+}
+
+func ToRepoDimensions(dimensions *model.Dimensions) *repomodel.Dimensions {
+	if dimensions == nil {
+		return nil
+	}
+	return &repomodel.Dimensions{
+		Length:     dimensions.Length,
+		Width:      dimensions.Width,
+		Height:     dimensions.Height,
+		Weight:     dimensions.Weight,
+		LengthUnit: ToRepoLengthUnit(dimensions.LengthUnit),
+		WeightUnit: ToRepoWeightUnit(dimensions.WeightUnit),
+	}
+}
+
+func ToRepoLengthUnit(unit model.LengthUnit) repomodel.LengthUnit {
+	switch unit {
+	case model.LengthUnitCentimeter:
+		return repomodel.LengthUnitCentimeter
+	case model.LengthUnitInch:
+		return repomodel.LengthUnitInch
+	default:
+		return repomodel.LengthUnitUnspecified
+	}
+}
+
+func ToRepoWeightUnit(unit model.WeightUnit) repomodel.WeightUnit {
+	switch unit {
+	case model.WeightUnitKilogram:
+		return repomodel.WeightUnitKilogram
+	case model.WeightUnitPound:
+		return repomodel.WeightUnitPound
+	default:
+		return repomodel.WeightUnitUnspecified
+	}
+}
+
+func ToRepoManufacturer(manufacturer *model.Manufacturer) *repomodel.Manufacturer {
+	if manufacturer == nil {
+		return nil
+	}
+	return &repomodel.Manufacturer{
+		Name:    manufacturer.Name,
+		Country: manufacturer.Country,
+		Website: manufacturer.Website,
+	}
+}
+
+func ToRepoValueMap(metadata map[string]*model.Value) map[string]*repomodel.Value {
+	if metadata == nil {
+		return nil
+	}
+	result := make(map[string]*repomodel.Value, len(metadata))
+	for key, value := range metadata {
+		result[key] = ToRepoValue(value)
+	}
+	return result
+}
+
+func ToRepoValue(value *model.Value) *repomodel.Value {
+	if value == nil {
+		return nil
+	}
+	return &repomodel.Value{
+		StringValue: value.StringValue,
+		Int64Value:  value.Int64Value,
+		DoubleValue: value.DoubleValue,
+		BoolValue:   value.BoolValue,
+	}
+}
+
 func ToModelValue(value *repomodel.Value) *model.Value {
 	if value == nil {
 		return nil
@@ -84,3 +215,58 @@ func ToModelValue(value *repomodel.Value) *model.Value {
 		BoolValue:   value.BoolValue,
 	}
 }
+
+func ToModelAttachments(attachments []repomodel.Attachment) []model.Attachment {
+	if attachments == nil {
+		return nil
+	}
+	result := make([]model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, model.Attachment{
+			Uuid:           attachment.Uuid,
+			Filename:       attachment.Filename,
+			ContentType:    attachment.ContentType,
+			Kind:           ToModelAttachmentKind(attachment.Kind),
+			SizeBytes:      attachment.SizeBytes,
+			ChecksumSha256: attachment.ChecksumSha256,
+			HasThumbnail:   attachment.HasThumbnail,
+			CreatedAt:      attachment.CreatedAt,
+		})
+	}
+	return result
+}
+
+func ToModelAttachmentKind(kind repomodel.AttachmentKind) model.AttachmentKind {
+	switch kind {
+	case repomodel.AttachmentKindImage:
+		return model.AttachmentKindImage
+	case repomodel.AttachmentKindDocument:
+		return model.AttachmentKindDocument
+	default:
+		return model.AttachmentKindUnspecified
+	}
+}
+
+func ToRepoAttachment(attachment model.Attachment) repomodel.Attachment {
+	return repomodel.Attachment{
+		Uuid:           attachment.Uuid,
+		Filename:       attachment.Filename,
+		ContentType:    attachment.ContentType,
+		Kind:           ToRepoAttachmentKind(attachment.Kind),
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSha256: attachment.ChecksumSha256,
+		HasThumbnail:   attachment.HasThumbnail,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
+
+func ToRepoAttachmentKind(kind model.AttachmentKind) repomodel.AttachmentKind {
+	switch kind {
+	case model.AttachmentKindImage:
+		return repomodel.AttachmentKindImage
+	case model.AttachmentKindDocument:
+		return repomodel.AttachmentKindDocument
+	default:
+		return repomodel.AttachmentKindUnspecified
+	}
+}
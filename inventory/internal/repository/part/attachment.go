@@ -0,0 +1,27 @@
+package part
+
+import (
+	"context"
+	"slices"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+// Adds attachment metadata to the part.
+func (r *repository) AddAttachment(ctx context.Context, partUuid string, attachment model.Attachment) error {
+	repoAttachment := converter.ToRepoAttachment(attachment)
+
+	return r.write(func(parts map[string]repomodel.Part) error {
+		part, ok := parts[partUuid]
+		if !ok {
+			return model.ErrPartNotFound
+		}
+
+		// Clone to not share the backing array with published snapshots.
+		part.Attachments = append(slices.Clone(part.Attachments), repoAttachment)
+		parts[partUuid] = part
+		return nil
+	})
+}
@@ -0,0 +1,23 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+// Stores a new part.
+func (r *repository) Create(ctx context.Context, part *model.Part) error {
+	repoPart := converter.ToRepoPart(part)
+
+	return r.write(func(parts map[string]repomodel.Part) error {
+		if _, ok := parts[repoPart.Uuid]; ok {
+			return fmt.Errorf("part with uuid %s already exists", repoPart.Uuid)
+		}
+		parts[repoPart.Uuid] = repoPart
+		return nil
+	})
+}
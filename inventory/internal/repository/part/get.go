@@ -8,9 +8,7 @@ import (
 )
 
 func (r *repository) Get(ctx context.Context, uuid string) (*model.Part, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	part, ok := r.parts[uuid]
+	part, ok := r.load().parts[uuid]
 	if !ok {
 		return nil, model.ErrPartNotFound
 	}
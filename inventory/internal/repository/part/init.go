@@ -9,24 +9,25 @@ import (
 	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
 )
 
-func (r *repository) initParts(count int) error {
+func initParts(parts map[string]repomodel.Part, count int) error {
 	if count < 0 {
 		return fmt.Errorf("failed to execute initParts")
 	}
 
-	parts := createParts(count)
-	for _, part := range parts {
-		r.parts[part.Uuid] = part
+	for _, part := range createParts(count) {
+		parts[part.Uuid] = part
 	}
 	return nil
 }
 
 func fakeDimensions() *repomodel.Dimensions {
 	return &repomodel.Dimensions{
-		Length: gofakeit.Float64Range(1.0, 300.0),
-		Width:  gofakeit.Float64Range(1.0, 300.0),
-		Height: gofakeit.Float64Range(0.5, 150.0),
-		Weight: gofakeit.Float64Range(0.1, 500.0),
+		Length:     gofakeit.Float64Range(1.0, 300.0),
+		Width:      gofakeit.Float64Range(1.0, 300.0),
+		Height:     gofakeit.Float64Range(0.5, 150.0),
+		Weight:     gofakeit.Float64Range(0.1, 500.0),
+		LengthUnit: repomodel.LengthUnitCentimeter,
+		WeightUnit: repomodel.WeightUnitKilogram,
 	}
 }
 
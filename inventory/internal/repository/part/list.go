@@ -6,13 +6,11 @@ import (
 
 	"github.com/qyrlabs/test-backend/inventory/internal/model"
 	"github.com/qyrlabs/test-backend/inventory/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
 )
 
 // Returns List of Parts by filter.
 func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
 	uuids := filter.Uuids
 	names := filter.Names
 	categories := filter.Categories
@@ -20,12 +18,13 @@ func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*mod
 	tags := filter.Tags
 
 	filteredParts := make([]*model.Part, 0)
-	for uuid, part := range r.parts {
+	for uuid, part := range r.load().parts {
 		if (len(uuids) == 0 || slices.Contains(uuids, uuid)) &&
 			(len(names) == 0 || slices.Contains(names, part.Name)) &&
 			(len(categories) == 0 || slices.Contains(categories, converter.ToModelCategory(part.Category))) &&
-			(len(countries) == 0 || slices.Contains(countries, part.Manufacturer.Country)) &&
-			(len(tags) == 0 || slices.Equal(tags, part.Tags)) {
+			(len(countries) == 0 || (part.Manufacturer != nil && slices.Contains(countries, part.Manufacturer.Country))) &&
+			(len(tags) == 0 || slices.Equal(tags, part.Tags)) &&
+			matchMetadataRanges(filter.MetadataRanges, part.Metadata) {
 			partModel := converter.ToModelPart(part)
 			filteredParts = append(filteredParts, partModel)
 		}
@@ -33,3 +32,18 @@ func (r *repository) List(ctx context.Context, filter model.PartsFilter) ([]*mod
 
 	return filteredParts, nil
 }
+
+// matchMetadataRanges reports whether numeric metadata values are within all ranges.
+// Parts without the key or with a non-numeric value do not match.
+func matchMetadataRanges(ranges []model.MetadataRange, metadata map[string]*repomodel.Value) bool {
+	for _, r := range ranges {
+		value, ok := converter.ToModelValue(metadata[r.Key]).Number()
+		if !ok {
+			return false
+		}
+		if (r.Min != nil && value < *r.Min) || (r.Max != nil && value > *r.Max) {
+			return false
+		}
+	}
+	return true
+}
@@ -2,7 +2,9 @@ package part
 
 import (
 	"log"
+	"maps"
 	"sync"
+	"sync/atomic"
 
 	def "github.com/qyrlabs/test-backend/inventory/internal/repository"
 	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
@@ -10,18 +12,91 @@ import (
 
 var _ def.PartRepository = &repository{}
 
+// repository keeps parts in an immutable snapshot published through an
+// atomic pointer. Readers load the current snapshot and take no locks, so
+// a List always sees a consistent point-in-time view and never blocks
+// writers. Writers copy the snapshot, apply their changes and publish the
+// copy. Concurrent writes are batched so that one copy serves many of them.
 type repository struct {
-	mu    sync.RWMutex
+	snapshot atomic.Pointer[snapshot]
+
+	// writeMu serializes snapshot publishing.
+	writeMu sync.Mutex
+	// pendingMu guards pending writes waiting for the next batch.
+	pendingMu sync.Mutex
+	pending   []*write
+}
+
+// snapshot is a point-in-time view of parts. It must not be modified once published.
+type snapshot struct {
 	parts map[string]repomodel.Part
 }
 
-func NewRepository() *repository {
-	repository := repository{
-		parts: make(map[string]repomodel.Part),
-	}
-	err := repository.initParts(100)
-	if err != nil {
+// write is a change of parts applied within a batch.
+// apply must either fail without changes or succeed.
+type write struct {
+	apply func(parts map[string]repomodel.Part) error
+	done  chan error
+}
+
+// NewRepository creates a repository seeded with seedSize fake parts.
+func NewRepository(seedSize int) *repository {
+	repository := &repository{}
+
+	parts := make(map[string]repomodel.Part)
+	if err := initParts(parts, seedSize); err != nil {
 		log.Println("failed to init parts")
 	}
-	return &repository
+	repository.snapshot.Store(&snapshot{parts: parts})
+
+	return repository
+}
+
+// load returns the current snapshot.
+func (r *repository) load() *snapshot {
+	return r.snapshot.Load()
+}
+
+// write enqueues apply and waits until the batch containing it is published.
+func (r *repository) write(apply func(parts map[string]repomodel.Part) error) error {
+	w := &write{
+		apply: apply,
+		done:  make(chan error, 1),
+	}
+
+	r.pendingMu.Lock()
+	r.pending = append(r.pending, w)
+	r.pendingMu.Unlock()
+
+	// Whoever takes the lock first publishes all writes pending by then,
+	// the others find their writes already done.
+	r.writeMu.Lock()
+	r.flush()
+	r.writeMu.Unlock()
+
+	return <-w.done
+}
+
+// flush applies pending writes to a copy of the current snapshot and publishes it.
+func (r *repository) flush() {
+	r.pendingMu.Lock()
+	batch := r.pending
+	r.pending = nil
+	r.pendingMu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	parts := maps.Clone(r.load().parts)
+	errs := make([]error, len(batch))
+	for i, w := range batch {
+		errs[i] = w.apply(parts)
+	}
+	r.snapshot.Store(&snapshot{parts: parts})
+
+	// Report results only after publishing so that writers read their writes.
+	for i, w := range batch {
+		w.done <- errs[i]
+	}
 }
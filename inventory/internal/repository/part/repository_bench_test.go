@@ -0,0 +1,160 @@
+package part
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+const (
+	// benchmarkWriters is the number of goroutines updating parts under read benchmarks.
+	benchmarkWriters = 4
+	// benchmarkParts is the number of parts the benchmarked repository is seeded with.
+	benchmarkParts = 100
+)
+
+func benchmarkRepository(b *testing.B) (*repository, []string) {
+	b.Helper()
+
+	repo := NewRepository(benchmarkParts)
+	return repo, slices.Collect(maps.Keys(repo.load().parts))
+}
+
+// startWriters keeps updating parts until the returned stop function is called.
+func startWriters(b *testing.B, repo *repository, uuids []string) (stop func()) {
+	b.Helper()
+
+	var (
+		done   = make(chan struct{})
+		wg     sync.WaitGroup
+		writes atomic.Int64
+	)
+	for w := range benchmarkWriters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := context.Background()
+			for i := w; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				info := model.PartInfo{
+					Name:       "part",
+					PriceMinor: int64(i),
+					Category:   model.CategoryEngine,
+				}
+				if _, err := repo.Update(ctx, uuids[i%len(uuids)], info); err != nil {
+					b.Error(err)
+					return
+				}
+				writes.Add(1)
+			}
+		}()
+	}
+
+	return func() {
+		close(done)
+		wg.Wait()
+		b.ReportMetric(float64(writes.Load())/float64(b.N), "writes/op")
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	repo, uuids := benchmarkRepository(b)
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := context.Background()
+		for i := 0; pb.Next(); i++ {
+			if _, err := repo.Get(ctx, uuids[i%len(uuids)]); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkGetWithConcurrentWrites(b *testing.B) {
+	repo, uuids := benchmarkRepository(b)
+	stop := startWriters(b, repo, uuids)
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := context.Background()
+		for i := 0; pb.Next(); i++ {
+			if _, err := repo.Get(ctx, uuids[i%len(uuids)]); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+
+	b.StopTimer()
+	stop()
+}
+
+func BenchmarkList(b *testing.B) {
+	repo, _ := benchmarkRepository(b)
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := context.Background()
+		for pb.Next() {
+			if _, err := repo.List(ctx, model.PartsFilter{}); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkListWithConcurrentWrites(b *testing.B) {
+	repo, uuids := benchmarkRepository(b)
+	stop := startWriters(b, repo, uuids)
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := context.Background()
+		for pb.Next() {
+			parts, err := repo.List(ctx, model.PartsFilter{})
+			if err != nil {
+				b.Error(err)
+				return
+			}
+			if len(parts) != len(uuids) {
+				b.Errorf("listed %d parts, want %d", len(parts), len(uuids))
+				return
+			}
+		}
+	})
+
+	b.StopTimer()
+	stop()
+}
+
+func BenchmarkUpdateParallel(b *testing.B) {
+	repo, uuids := benchmarkRepository(b)
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		ctx := context.Background()
+		for i := 0; pb.Next(); i++ {
+			info := model.PartInfo{
+				Name:       "part",
+				PriceMinor: int64(i),
+				Category:   model.CategoryEngine,
+			}
+			if _, err := repo.Update(ctx, uuids[i%len(uuids)], info); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
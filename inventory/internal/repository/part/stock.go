@@ -0,0 +1,60 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+// Changes stock quantities of parts, all or none.
+func (r *repository) AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) ([]model.StockLevel, error) {
+	var levels []model.StockLevel
+	err := r.write(func(parts map[string]repomodel.Part) error {
+		// Validate everything first, apply must not fail halfway.
+		stock := make(map[string]int64, len(adjustments))
+		for _, adjustment := range adjustments {
+			part, ok := parts[adjustment.PartUuid]
+			if !ok {
+				return fmt.Errorf("%w: %s", model.ErrPartNotFound, adjustment.PartUuid)
+			}
+			quantity, ok := stock[adjustment.PartUuid]
+			if !ok {
+				quantity = part.StockQuantity
+			}
+			quantity += adjustment.Delta
+			if quantity < 0 {
+				return fmt.Errorf("%w: part %s has %d in stock", model.ErrInsufficientStock, adjustment.PartUuid, part.StockQuantity)
+			}
+			stock[adjustment.PartUuid] = quantity
+		}
+
+		now := time.Now()
+		levels = make([]model.StockLevel, 0, len(stock))
+		for _, adjustment := range adjustments {
+			quantity, ok := stock[adjustment.PartUuid]
+			if !ok {
+				continue
+			}
+			delete(stock, adjustment.PartUuid)
+
+			part := parts[adjustment.PartUuid]
+			part.StockQuantity = quantity
+			part.UpdatedAt = &now
+			parts[adjustment.PartUuid] = part
+
+			levels = append(levels, model.StockLevel{
+				PartUuid:      adjustment.PartUuid,
+				StockQuantity: quantity,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
@@ -0,0 +1,43 @@
+package part
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+// Replaces writable fields of the part.
+func (r *repository) Update(ctx context.Context, uuid string, info model.PartInfo) (*model.Part, error) {
+	var updated repomodel.Part
+	err := r.write(func(parts map[string]repomodel.Part) error {
+		part, ok := parts[uuid]
+		if !ok {
+			return model.ErrPartNotFound
+		}
+
+		now := time.Now()
+		part.Name = info.Name
+		part.Description = info.Description
+		part.PriceMinor = info.PriceMinor
+		part.StockQuantity = info.StockQuantity
+		part.Category = converter.ToRepoCategory(info.Category)
+		part.Dimensions = converter.ToRepoDimensions(info.Dimensions)
+		part.Manufacturer = converter.ToRepoManufacturer(info.Manufacturer)
+		part.Tags = slices.Clone(info.Tags)
+		part.Metadata = converter.ToRepoValueMap(info.Metadata)
+		part.UpdatedAt = &now
+		parts[uuid] = part
+
+		updated = part
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToModelPart(updated), nil
+}
@@ -0,0 +1,30 @@
+package repomodel
+
+// MetadataSchema declares metadata keys allowed for parts of a category.
+type MetadataSchema struct {
+	Category Category
+	Fields   []MetadataField
+}
+
+// MetadataField declares a metadata key.
+type MetadataField struct {
+	Key           string
+	Kind          ValueKind
+	Required      bool
+	Unit          string
+	Min           *float64
+	Max           *float64
+	AllowedValues []*Value
+	Description   string
+}
+
+// Kind of a metadata Value.
+type ValueKind int32
+
+const (
+	ValueKindUnspecified ValueKind = 0
+	ValueKindString      ValueKind = 1
+	ValueKindInt64       ValueKind = 2
+	ValueKindDouble      ValueKind = 3
+	ValueKindBool        ValueKind = 4
+)
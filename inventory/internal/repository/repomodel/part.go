@@ -27,6 +27,8 @@ type Part struct {
 	CreatedAt *time.Time
 	// Last update timestamp.
 	UpdatedAt *time.Time
+	// Images and documents attached to the part.
+	Attachments []Attachment
 }
 
 // Category of the Part.
@@ -41,13 +43,61 @@ const (
 )
 
 // Dimenstions of the Part.
+// Length, Width and Height are measured in LengthUnit, Weight in WeightUnit.
 type Dimensions struct {
-	Length float64
-	Width  float64
-	Height float64
-	Weight float64
+	Length     float64
+	Width      float64
+	Height     float64
+	Weight     float64
+	LengthUnit LengthUnit
+	WeightUnit WeightUnit
 }
 
+// Unit of length.
+type LengthUnit int32
+
+const (
+	LengthUnitUnspecified LengthUnit = 0
+	LengthUnitCentimeter  LengthUnit = 1
+	LengthUnitInch        LengthUnit = 2
+)
+
+// Unit of weight.
+type WeightUnit int32
+
+const (
+	WeightUnitUnspecified WeightUnit = 0
+	WeightUnitKilogram    WeightUnit = 1
+	WeightUnitPound       WeightUnit = 2
+)
+
+// Attachment is an image or a document attached to the Part.
+type Attachment struct {
+	Uuid string
+	// Original file name.
+	Filename string
+	// Content type detected from the content.
+	ContentType string
+	Kind        AttachmentKind
+	// Content size in bytes.
+	SizeBytes int64
+	// Hex-encoded SHA-256 of the content.
+	ChecksumSha256 string
+	// Whether a PNG thumbnail is available.
+	HasThumbnail bool
+	// Upload timestamp.
+	CreatedAt *time.Time
+}
+
+// Kind of the Attachment.
+type AttachmentKind int32
+
+const (
+	AttachmentKindUnspecified AttachmentKind = 0
+	AttachmentKindImage       AttachmentKind = 1
+	AttachmentKindDocument    AttachmentKind = 2
+)
+
 // Manufacturer of the Part.
 type Manufacturer struct {
 	Name    string
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"io"
 
 	"github.com/qyrlabs/test-backend/inventory/internal/model"
 )
@@ -9,4 +10,25 @@ import (
 type PartRepository interface {
 	Get(ctx context.Context, uuid string) (*model.Part, error)
 	List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	Create(ctx context.Context, part *model.Part) error
+	// Update replaces writable fields of the part and returns the updated part.
+	Update(ctx context.Context, uuid string, info model.PartInfo) (*model.Part, error)
+	AddAttachment(ctx context.Context, partUuid string, attachment model.Attachment) error
+	// AdjustStock applies all adjustments or none of them.
+	AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) ([]model.StockLevel, error)
+}
+
+type MetadataSchemaRepository interface {
+	Get(ctx context.Context, category model.Category) (*model.MetadataSchema, error)
+	List(ctx context.Context) ([]*model.MetadataSchema, error)
+	Set(ctx context.Context, schema model.MetadataSchema) error
+}
+
+// BlobRepository stores binary content by key.
+type BlobRepository interface {
+	// Put stores content read from r under key and returns its size.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	// Get opens content stored under key. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
 }
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"context"
+	"sync"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	def "github.com/qyrlabs/test-backend/inventory/internal/repository"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository/repomodel"
+)
+
+var _ def.MetadataSchemaRepository = &repository{}
+
+type repository struct {
+	mu      sync.RWMutex
+	schemas map[repomodel.Category]repomodel.MetadataSchema
+}
+
+func NewRepository() *repository {
+	return &repository{
+		schemas: make(map[repomodel.Category]repomodel.MetadataSchema),
+	}
+}
+
+// Returns metadata schema of the category.
+func (r *repository) Get(ctx context.Context, category model.Category) (*model.MetadataSchema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schema, ok := r.schemas[converter.ToRepoCategory(category)]
+	if !ok {
+		return nil, model.ErrMetadataSchemaNotFound
+	}
+	return converter.ToModelMetadataSchema(schema), nil
+}
+
+// Returns metadata schemas of all categories.
+func (r *repository) List(ctx context.Context) ([]*model.MetadataSchema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schemas := make([]*model.MetadataSchema, 0, len(r.schemas))
+	for _, schema := range r.schemas {
+		schemas = append(schemas, converter.ToModelMetadataSchema(schema))
+	}
+	return schemas, nil
+}
+
+// Replaces metadata schema of the category.
+func (r *repository) Set(ctx context.Context, schema model.MetadataSchema) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repoSchema := converter.ToRepoMetadataSchema(schema)
+	r.schemas[repoSchema.Category] = repoSchema
+	return nil
+}
@@ -0,0 +1,47 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// Opens attachment content or its thumbnail. The caller must close the content.
+func (s *service) Download(ctx context.Context, partUuid, attachmentUuid string, thumbnail bool) (*model.Attachment, io.ReadCloser, error) {
+	part, err := s.partRepository.Get(ctx, partUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attachment *model.Attachment
+	for i := range part.Attachments {
+		if part.Attachments[i].Uuid == attachmentUuid {
+			attachment = &part.Attachments[i]
+			break
+		}
+	}
+	if attachment == nil {
+		return nil, nil, model.ErrAttachmentNotFound
+	}
+
+	key := contentKey(partUuid, attachmentUuid)
+	if thumbnail {
+		if !attachment.HasThumbnail {
+			return nil, nil, fmt.Errorf("%w: no thumbnail", model.ErrAttachmentNotFound)
+		}
+		key = thumbnailKey(partUuid, attachmentUuid)
+	}
+
+	content, err := s.blobRepository.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, model.ErrBlobNotFound) {
+			return nil, nil, fmt.Errorf("%w: content is missing", model.ErrAttachmentNotFound)
+		}
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"fmt"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/repository"
+	def "github.com/qyrlabs/test-backend/inventory/internal/service"
+)
+
+var _ def.AttachmentService = &service{}
+
+type service struct {
+	partRepository repository.PartRepository
+	blobRepository repository.BlobRepository
+}
+
+func NewService(partRepository repository.PartRepository, blobRepository repository.BlobRepository) *service {
+	return &service{
+		partRepository: partRepository,
+		blobRepository: blobRepository,
+	}
+}
+
+func contentKey(partUuid, attachmentUuid string) string {
+	return fmt.Sprintf("parts/%s/%s", partUuid, attachmentUuid)
+}
+
+func thumbnailKey(partUuid, attachmentUuid string) string {
+	return fmt.Sprintf("parts/%s/%s.thumbnail.png", partUuid, attachmentUuid)
+}
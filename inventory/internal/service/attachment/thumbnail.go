@@ -0,0 +1,107 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"  // register GIF decoder
+	_ "image/jpeg" // register JPEG decoder
+	"image/png"
+)
+
+const (
+	// thumbnailSize is the maximum side of a thumbnail in pixels.
+	thumbnailSize = 256
+	// maxThumbnailSourcePixels protects from decoding huge images.
+	maxThumbnailSourcePixels = 40_000_000
+)
+
+// createThumbnail decodes the image stored under srcKey and stores its
+// scaled down PNG copy under dstKey.
+func (s *service) createThumbnail(ctx context.Context, srcKey, dstKey string) error {
+	config, err := s.decodeConfig(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	if config.Width*config.Height > maxThumbnailSourcePixels {
+		return fmt.Errorf("image is too large: %dx%d", config.Width, config.Height)
+	}
+
+	src, err := s.blobRepository.Get(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, scaleDown(img, thumbnailSize)); err != nil {
+		return fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	_, err = s.blobRepository.Put(ctx, dstKey, &buf)
+	return err
+}
+
+func (s *service) decodeConfig(ctx context.Context, key string) (image.Config, error) {
+	src, err := s.blobRepository.Get(ctx, key)
+	if err != nil {
+		return image.Config{}, err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	config, _, err := image.DecodeConfig(src)
+	if err != nil {
+		return image.Config{}, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	return config, nil
+}
+
+// scaleDown fits img into maxSide x maxSide keeping the aspect ratio.
+// Every destination pixel is the average of the source pixels it covers.
+func scaleDown(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := srcW, srcH
+	switch {
+	case srcW <= maxSide && srcH <= maxSide:
+	case srcW >= srcH:
+		dstW, dstH = maxSide, max(1, srcH*maxSide/srcW)
+	default:
+		dstW, dstH = max(1, srcW*maxSide/srcH), maxSide
+	}
+
+	dst := image.NewRGBA64(image.Rect(0, 0, dstW, dstH))
+	for y := range dstH {
+		y0 := bounds.Min.Y + y*srcH/dstH
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/dstH)
+		for x := range dstW {
+			x0 := bounds.Min.X + x*srcW/dstW
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/dstW)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			// #nosec G115 -- average of 16-bit values fits into uint16
+			dst.SetRGBA64(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+
+	return dst
+}
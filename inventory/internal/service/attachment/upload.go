@@ -0,0 +1,123 @@
+package attachment
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+const (
+	// maxAttachmentSize limits size of uploaded content.
+	maxAttachmentSize = 20 << 20
+	// sniffLen is the number of bytes http.DetectContentType considers.
+	sniffLen = 512
+)
+
+// allowedContentTypes maps accepted content types to attachment kinds.
+var allowedContentTypes = map[string]model.AttachmentKind{
+	"image/png":       model.AttachmentKindImage,
+	"image/jpeg":      model.AttachmentKindImage,
+	"image/gif":       model.AttachmentKindImage,
+	"application/pdf": model.AttachmentKindDocument,
+	"text/plain":      model.AttachmentKindDocument,
+}
+
+// Uploads attachment content and adds it to the part.
+// Content type is sniffed from the content, the client-provided one is not trusted.
+func (s *service) Upload(ctx context.Context, upload model.AttachmentUpload, content io.Reader) (*model.Attachment, error) {
+	if _, err := s.partRepository.Get(ctx, upload.PartUuid); err != nil {
+		return nil, err
+	}
+
+	buffered := bufio.NewReaderSize(content, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: empty content", model.ErrUnsupportedContentType)
+	}
+
+	contentType := detectContentType(head)
+	kind, ok := allowedContentTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", model.ErrUnsupportedContentType, contentType)
+	}
+
+	attachment := model.Attachment{
+		Uuid:        uuid.NewString(),
+		Filename:    upload.Filename,
+		ContentType: contentType,
+		Kind:        kind,
+	}
+	key := contentKey(upload.PartUuid, attachment.Uuid)
+
+	hash := sha256.New()
+	// Read one byte over the limit to detect oversized content.
+	limited := io.LimitReader(buffered, maxAttachmentSize+1)
+	size, err := s.blobRepository.Put(ctx, key, io.TeeReader(limited, hash))
+	if err != nil {
+		return nil, err
+	}
+	if size > maxAttachmentSize {
+		s.deleteBlob(ctx, key)
+		return nil, fmt.Errorf("%w: limit is %d bytes", model.ErrAttachmentTooLarge, maxAttachmentSize)
+	}
+
+	attachment.SizeBytes = size
+	attachment.ChecksumSha256 = hex.EncodeToString(hash.Sum(nil))
+	if upload.ChecksumSha256 != "" && !strings.EqualFold(upload.ChecksumSha256, attachment.ChecksumSha256) {
+		s.deleteBlob(ctx, key)
+		return nil, model.ErrChecksumMismatch
+	}
+
+	if kind == model.AttachmentKindImage {
+		err := s.createThumbnail(ctx, key, thumbnailKey(upload.PartUuid, attachment.Uuid))
+		if err != nil {
+			log.Printf("failed to create thumbnail for attachment %s: %v", attachment.Uuid, err)
+		}
+		attachment.HasThumbnail = err == nil
+	}
+
+	now := time.Now()
+	attachment.CreatedAt = &now
+
+	if err := s.partRepository.AddAttachment(ctx, upload.PartUuid, attachment); err != nil {
+		s.deleteBlob(ctx, key)
+		if attachment.HasThumbnail {
+			s.deleteBlob(ctx, thumbnailKey(upload.PartUuid, attachment.Uuid))
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// detectContentType returns sniffed media type without parameters.
+func detectContentType(head []byte) string {
+	contentType := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	return mediaType
+}
+
+func (s *service) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobRepository.Delete(ctx, key); err != nil {
+		log.Printf("failed to delete blob %s: %v", key, err)
+	}
+}
@@ -0,0 +1,38 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// Creates a part. Dimensions are stored in metric units.
+func (s *service) Create(ctx context.Context, info model.PartInfo) (*model.Part, error) {
+	info.Dimensions = info.Dimensions.Convert(model.UnitSystemMetric)
+	if err := s.validate(ctx, info); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	part := &model.Part{
+		Uuid:          uuid.NewString(),
+		Name:          info.Name,
+		Description:   info.Description,
+		PriceMinor:    info.PriceMinor,
+		StockQuantity: info.StockQuantity,
+		Category:      info.Category,
+		Dimensions:    info.Dimensions,
+		Manufacturer:  info.Manufacturer,
+		Tags:          info.Tags,
+		Metadata:      info.Metadata,
+		CreatedAt:     &now,
+		UpdatedAt:     &now,
+	}
+	if err := s.partRepository.Create(ctx, part); err != nil {
+		return nil, err
+	}
+	return part, nil
+}
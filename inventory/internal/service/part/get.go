@@ -7,10 +7,11 @@ import (
 )
 
 // Get part info by its UUID.
-func (s *service) Get(ctx context.Context, uuid string) (*model.Part, error) {
+func (s *service) Get(ctx context.Context, uuid string, unitSystem model.UnitSystem) (*model.Part, error) {
 	part, err := s.partRepository.Get(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
+	part.Dimensions = part.Dimensions.Convert(unitSystem)
 	return part, nil
 }
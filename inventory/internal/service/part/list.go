@@ -1,16 +1,100 @@
 package part
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/qyrlabs/test-backend/inventory/internal/model"
 )
 
 // Returns List of Parts by filter.
-func (s *service) List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+func (s *service) List(ctx context.Context, filter model.PartsFilter, sort *model.PartsSort, unitSystem model.UnitSystem) ([]*model.Part, error) {
+	keys := make([]string, 0, len(filter.MetadataRanges)+1)
+	for _, r := range filter.MetadataRanges {
+		keys = append(keys, r.Key)
+	}
+	if sort != nil {
+		keys = append(keys, sort.MetadataKey)
+	}
+	if err := s.checkNumericKeys(ctx, filter.Categories, keys); err != nil {
+		return nil, err
+	}
+
 	parts, err := s.partRepository.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
+	if sort != nil {
+		sortByMetadata(parts, *sort)
+	}
+	for _, part := range parts {
+		part.Dimensions = part.Dimensions.Convert(unitSystem)
+	}
 	return parts, nil
 }
+
+// checkNumericKeys checks that metadata keys are declared numeric in schemas
+// of all categories, or of any category if categories are not specified.
+func (s *service) checkNumericKeys(ctx context.Context, categories []model.Category, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	schemas, err := s.schemaRepository.List(ctx)
+	if err != nil {
+		return err
+	}
+	if len(categories) > 0 {
+		schemas = slices.DeleteFunc(schemas, func(schema *model.MetadataSchema) bool {
+			return !slices.Contains(categories, schema.Category)
+		})
+	}
+
+	for _, key := range keys {
+		declaredIn := make([]model.Category, 0, len(schemas))
+		for _, schema := range schemas {
+			if field, ok := schema.Field(key); ok && field.Kind.IsNumeric() {
+				declaredIn = append(declaredIn, schema.Category)
+			}
+		}
+
+		declared := len(declaredIn) > 0
+		for _, category := range categories {
+			declared = declared && slices.Contains(declaredIn, category)
+		}
+		if !declared {
+			return fmt.Errorf("%w: %q", model.ErrMetadataKeyNotNumeric, key)
+		}
+	}
+	return nil
+}
+
+// sortByMetadata sorts parts by numeric metadata value, parts without it go last.
+func sortByMetadata(parts []*model.Part, sort model.PartsSort) {
+	slices.SortStableFunc(parts, func(a, b *model.Part) int {
+		av, aok := a.Metadata[sort.MetadataKey].Number()
+		bv, bok := b.Metadata[sort.MetadataKey].Number()
+		switch {
+		case !aok || !bok:
+			// Parts with value go first regardless of direction.
+			return boolCompare(bok, aok)
+		case sort.Descending:
+			return cmp.Compare(bv, av)
+		default:
+			return cmp.Compare(av, bv)
+		}
+	})
+}
+
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -8,11 +8,13 @@ import (
 var _ def.PartService = &service{}
 
 type service struct {
-	partRepository repository.PartRepository
+	partRepository   repository.PartRepository
+	schemaRepository repository.MetadataSchemaRepository
 }
 
-func NewService(partRepository repository.PartRepository) *service {
+func NewService(partRepository repository.PartRepository, schemaRepository repository.MetadataSchemaRepository) *service {
 	return &service{
-		partRepository: partRepository,
+		partRepository:   partRepository,
+		schemaRepository: schemaRepository,
 	}
 }
@@ -0,0 +1,53 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// Returns aggregated dimensions and weight of a set of parts for shipping.
+func (s *service) ShippingInfo(ctx context.Context, items []model.ShippingItem, unitSystem model.UnitSystem) (*model.ShippingInfo, error) {
+	uuids := make([]string, 0, len(items))
+	for _, item := range items {
+		uuids = append(uuids, item.PartUuid)
+	}
+
+	parts, err := s.partRepository.List(ctx, model.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+
+	partsByUuid := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		partsByUuid[part.Uuid] = part
+	}
+
+	lengthUnit, weightUnit := unitSystem.Units()
+	info := &model.ShippingInfo{
+		LengthUnit: lengthUnit,
+		WeightUnit: weightUnit,
+	}
+	for _, item := range items {
+		part, ok := partsByUuid[item.PartUuid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, item.PartUuid)
+		}
+		info.TotalQuantity += item.Quantity
+
+		dimensions := part.Dimensions.Convert(unitSystem)
+		if dimensions == nil {
+			continue
+		}
+		quantity := float64(item.Quantity)
+
+		info.TotalWeight += dimensions.Weight * quantity
+		info.TotalVolume += dimensions.Volume() * quantity
+		info.TotalVolumetricWeight += dimensions.VolumetricWeight() * quantity
+		info.MaxLength = max(info.MaxLength, dimensions.MaxSide())
+	}
+	info.ChargeableWeight = max(info.TotalWeight, info.TotalVolumetricWeight)
+
+	return info, nil
+}
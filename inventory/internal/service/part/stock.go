@@ -0,0 +1,12 @@
+package part
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// Changes stock quantities of parts, all or none.
+func (s *service) AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) ([]model.StockLevel, error) {
+	return s.partRepository.AdjustStock(ctx, adjustments)
+}
@@ -0,0 +1,17 @@
+package part
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// Replaces part info. Dimensions are stored in metric units.
+func (s *service) Update(ctx context.Context, uuid string, info model.PartInfo) (*model.Part, error) {
+	info.Dimensions = info.Dimensions.Convert(model.UnitSystemMetric)
+	if err := s.validate(ctx, info); err != nil {
+		return nil, err
+	}
+
+	return s.partRepository.Update(ctx, uuid, info)
+}
@@ -0,0 +1,85 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+)
+
+// validate checks part info and its metadata against the category schema.
+func (s *service) validate(ctx context.Context, info model.PartInfo) error {
+	var errs []error
+	if info.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if info.PriceMinor < 0 {
+		errs = append(errs, errors.New("price must not be negative"))
+	}
+	if info.StockQuantity < 0 {
+		errs = append(errs, errors.New("stock quantity must not be negative"))
+	}
+	if info.Category == model.CategoryUnspecified {
+		errs = append(errs, errors.New("category is required"))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", model.ErrInvalidPart, errors.Join(errs...))
+	}
+
+	schema, err := s.schemaRepository.Get(ctx, info.Category)
+	if err != nil {
+		if errors.Is(err, model.ErrMetadataSchemaNotFound) {
+			return nil
+		}
+		return err
+	}
+	return validateMetadata(schema, info.Metadata)
+}
+
+// validateMetadata checks that metadata has all required keys and only
+// declared keys with values matching their declarations.
+func validateMetadata(schema *model.MetadataSchema, metadata map[string]*model.Value) error {
+	var errs []error
+	for _, field := range schema.Fields {
+		if field.Required && metadata[field.Key].Kind() == model.ValueKindUnspecified {
+			errs = append(errs, fmt.Errorf("%q is required", field.Key))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(metadata)) {
+		field, ok := schema.Field(key)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%q is not declared", key))
+			continue
+		}
+		if err := validateValue(field, metadata[key]); err != nil {
+			errs = append(errs, fmt.Errorf("%q %w", key, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", model.ErrInvalidMetadata, errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateValue(field model.MetadataField, value *model.Value) error {
+	if value.Kind() != field.Kind {
+		return errors.New("has unexpected kind")
+	}
+	if number, ok := value.Number(); ok {
+		if field.Min != nil && number < *field.Min {
+			return fmt.Errorf("must be at least %g", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return fmt.Errorf("must be at most %g", *field.Max)
+		}
+	}
+	if len(field.AllowedValues) > 0 && !slices.ContainsFunc(field.AllowedValues, value.Equal) {
+		return errors.New("is not one of allowed values")
+	}
+	return nil
+}
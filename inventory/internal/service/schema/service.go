@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/qyrlabs/test-backend/inventory/internal/model"
+	"github.com/qyrlabs/test-backend/inventory/internal/repository"
+	def "github.com/qyrlabs/test-backend/inventory/internal/service"
+)
+
+var _ def.MetadataSchemaService = &service{}
+
+type service struct {
+	schemaRepository repository.MetadataSchemaRepository
+}
+
+func NewService(schemaRepository repository.MetadataSchemaRepository) *service {
+	return &service{
+		schemaRepository: schemaRepository,
+	}
+}
+
+// Returns metadata schema of the category.
+func (s *service) Get(ctx context.Context, category model.Category) (*model.MetadataSchema, error) {
+	return s.schemaRepository.Get(ctx, category)
+}
+
+// Returns metadata schemas of all categories ordered by category.
+func (s *service) List(ctx context.Context) ([]*model.MetadataSchema, error) {
+	schemas, err := s.schemaRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(schemas, func(a, b *model.MetadataSchema) int {
+		return int(a.Category - b.Category)
+	})
+	return schemas, nil
+}
+
+// Defines metadata schema of the category, replacing the previous one.
+// Existing parts are validated against the new schema on their next update.
+func (s *service) Set(ctx context.Context, schema model.MetadataSchema) (*model.MetadataSchema, error) {
+	if err := validateSchema(schema); err != nil {
+		return nil, err
+	}
+	if err := s.schemaRepository.Set(ctx, schema); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func validateSchema(schema model.MetadataSchema) error {
+	var errs []error
+	if schema.Category == model.CategoryUnspecified {
+		errs = append(errs, errors.New("category is required"))
+	}
+
+	keys := make(map[string]struct{}, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if field.Key == "" {
+			errs = append(errs, errors.New("key is required"))
+			continue
+		}
+		if _, ok := keys[field.Key]; ok {
+			errs = append(errs, fmt.Errorf("%q is declared twice", field.Key))
+		}
+		keys[field.Key] = struct{}{}
+
+		if err := validateField(field); err != nil {
+			errs = append(errs, fmt.Errorf("%q %w", field.Key, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", model.ErrInvalidMetadataSchema, errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateField(field model.MetadataField) error {
+	if field.Kind == model.ValueKindUnspecified {
+		return errors.New("has no kind")
+	}
+	if !field.Kind.IsNumeric() && (field.Min != nil || field.Max != nil) {
+		return errors.New("has range but is not numeric")
+	}
+	if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+		return errors.New("has min greater than max")
+	}
+	for _, value := range field.AllowedValues {
+		if value.Kind() != field.Kind {
+			return errors.New("has allowed value of unexpected kind")
+		}
+	}
+	return nil
+}
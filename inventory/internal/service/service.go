@@ -2,11 +2,27 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/qyrlabs/test-backend/inventory/internal/model"
 )
 
 type PartService interface {
-	Get(ctx context.Context, uuid string) (*model.Part, error)
-	List(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	Get(ctx context.Context, uuid string, unitSystem model.UnitSystem) (*model.Part, error)
+	List(ctx context.Context, filter model.PartsFilter, sort *model.PartsSort, unitSystem model.UnitSystem) ([]*model.Part, error)
+	Create(ctx context.Context, info model.PartInfo) (*model.Part, error)
+	Update(ctx context.Context, uuid string, info model.PartInfo) (*model.Part, error)
+	ShippingInfo(ctx context.Context, items []model.ShippingItem, unitSystem model.UnitSystem) (*model.ShippingInfo, error)
+	AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) ([]model.StockLevel, error)
+}
+
+type MetadataSchemaService interface {
+	Get(ctx context.Context, category model.Category) (*model.MetadataSchema, error)
+	List(ctx context.Context) ([]*model.MetadataSchema, error)
+	Set(ctx context.Context, schema model.MetadataSchema) (*model.MetadataSchema, error)
+}
+
+type AttachmentService interface {
+	Upload(ctx context.Context, upload model.AttachmentUpload, content io.Reader) (*model.Attachment, error)
+	Download(ctx context.Context, partUuid, attachmentUuid string, thumbnail bool) (*model.Attachment, io.ReadCloser, error)
 }
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
+)
+
+// Storage backends of orders, promo codes, webhooks and idempotency keys.
+const storageBackendMemory = "memory"
+
+// Config of the order service, loaded by config.Loader.
+type Config struct {
+	HTTP struct {
+		Addr              string        `yaml:"addr" usage:"address the http server listens on"`
+		RequestTimeout    time.Duration `yaml:"request_timeout" usage:"how long a request other than an event stream may take"`
+		ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" usage:"how long reading the headers of a request may take"`
+	} `yaml:"http"`
+	Inventory Upstream `yaml:"inventory"`
+	Payment   Upstream `yaml:"payment"`
+	Storage   struct {
+		Backend string `yaml:"backend" usage:"storage of orders, promo codes, webhooks and idempotency keys, only memory is supported"`
+	} `yaml:"storage"`
+
+	PaymentDeadline   time.Duration `yaml:"payment_deadline" usage:"how long a new order may stay unpaid before it expires" reload:"true"`
+	PriceChangePolicy string        `yaml:"price_change_policy" usage:"how to pay for orders whose parts were repriced in inventory: honor, fail or reconfirm" reload:"true"`
+	PricingRules      string        `yaml:"pricing_rules" usage:"path to a JSON file with tax and shipping rules, built-in rules are used when empty"`
+
+	PartCache struct {
+		TTL  time.Duration `yaml:"ttl" usage:"how long inventory parts are cached"`
+		Size int           `yaml:"size" usage:"how many inventory parts are cached at most"`
+	} `yaml:"part_cache"`
+	Webhooks struct {
+		Timeout     time.Duration `yaml:"timeout" usage:"how long a webhook delivery may take"`
+		MaxAttempts int64         `yaml:"max_attempts" usage:"how many times a webhook delivery is attempted"`
+		RetryDelay  time.Duration `yaml:"retry_delay" usage:"delay of the first webhook delivery retry, doubled with every retry"`
+	} `yaml:"webhooks"`
+	IdempotencyRetention time.Duration `yaml:"idempotency_retention" usage:"how long idempotency keys are kept"`
+	StatusReplaySize     int           `yaml:"status_replay_size" usage:"how many latest status changes are kept for clients resuming event streams"`
+	ShutdownTimeout      time.Duration `yaml:"shutdown_timeout" usage:"how long open requests are waited for on shutdown" reload:"true"`
+}
+
+// Upstream is a gRPC service the order service calls.
+type Upstream struct {
+	Addr string `yaml:"addr" usage:"address of the gRPC service"`
+	// Idempotent calls are split into attempts, the others get the whole budget.
+	Timeout        time.Duration `yaml:"timeout" usage:"deadline of a call including retries"`
+	AttemptTimeout time.Duration `yaml:"attempt_timeout" usage:"deadline of one attempt of an idempotent call"`
+	MaxAttempts    int           `yaml:"max_attempts" usage:"how many times an idempotent call is attempted"`
+	Breaker        struct {
+		FailureThreshold int           `yaml:"failure_threshold" usage:"consecutive failures that open the circuit breaker, disabled if zero"`
+		OpenTimeout      time.Duration `yaml:"open_timeout" usage:"how long the circuit breaker stays open before a probe call"`
+	} `yaml:"breaker"`
+}
+
+func defaultConfig() *Config {
+	cfg := &Config{}
+	cfg.HTTP.Addr = "localhost:8080"
+	cfg.HTTP.RequestTimeout = 10 * time.Second
+	cfg.HTTP.ReadHeaderTimeout = 5 * time.Second
+
+	cfg.Inventory.Addr = "localhost:50052"
+	cfg.Inventory.Timeout = 2 * time.Second
+	cfg.Inventory.AttemptTimeout = 700 * time.Millisecond
+	cfg.Inventory.MaxAttempts = 3
+	cfg.Inventory.Breaker.FailureThreshold = 5
+	cfg.Inventory.Breaker.OpenTimeout = 10 * time.Second
+
+	cfg.Payment.Addr = "localhost:50062"
+	cfg.Payment.Timeout = 4 * time.Second
+	cfg.Payment.AttemptTimeout = 1500 * time.Millisecond
+	cfg.Payment.MaxAttempts = 3
+	cfg.Payment.Breaker.FailureThreshold = 5
+	cfg.Payment.Breaker.OpenTimeout = 10 * time.Second
+
+	cfg.Storage.Backend = storageBackendMemory
+
+	cfg.PaymentDeadline = 30 * time.Minute
+	cfg.PriceChangePolicy = model.PriceChangePolicyHonor.String()
+
+	cfg.PartCache.TTL = 30 * time.Second
+	cfg.PartCache.Size = 10000
+	cfg.Webhooks.Timeout = 10 * time.Second
+	cfg.Webhooks.MaxAttempts = 10
+	cfg.Webhooks.RetryDelay = 5 * time.Second
+	cfg.IdempotencyRetention = 24 * time.Hour
+	cfg.StatusReplaySize = 1024
+	cfg.ShutdownTimeout = 10 * time.Second
+	return cfg
+}
+
+func (c *Config) Validate() error {
+	errs := []error{
+		config.ValidateAddr("http.addr", c.HTTP.Addr),
+		config.ValidatePositive("http.request_timeout", c.HTTP.RequestTimeout),
+		config.ValidatePositive("http.read_header_timeout", c.HTTP.ReadHeaderTimeout),
+		c.Inventory.validate("inventory"),
+		c.Payment.validate("payment"),
+		config.ValidatePositive("payment_deadline", c.PaymentDeadline),
+		config.ValidatePositive("part_cache.ttl", c.PartCache.TTL),
+		config.ValidatePositive("part_cache.size", c.PartCache.Size),
+		config.ValidatePositive("webhooks.timeout", c.Webhooks.Timeout),
+		config.ValidatePositive("webhooks.max_attempts", c.Webhooks.MaxAttempts),
+		config.ValidatePositive("webhooks.retry_delay", c.Webhooks.RetryDelay),
+		config.ValidatePositive("idempotency_retention", c.IdempotencyRetention),
+		config.ValidatePositive("status_replay_size", c.StatusReplaySize),
+		config.ValidatePositive("shutdown_timeout", c.ShutdownTimeout),
+	}
+	if c.Storage.Backend != storageBackendMemory {
+		errs = append(errs, fmt.Errorf("unknown storage.backend %q, want %s", c.Storage.Backend, storageBackendMemory))
+	}
+	if _, err := model.ParsePriceChangePolicy(c.PriceChangePolicy); err != nil {
+		errs = append(errs, fmt.Errorf("price_change_policy: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+func (u *Upstream) validate(name string) error {
+	errs := []error{
+		config.ValidateAddr(name+".addr", u.Addr),
+		config.ValidatePositive(name+".timeout", u.Timeout),
+		config.ValidatePositive(name+".attempt_timeout", u.AttemptTimeout),
+		config.ValidatePositive(name+".max_attempts", u.MaxAttempts),
+	}
+	if u.Breaker.FailureThreshold < 0 {
+		errs = append(errs, fmt.Errorf("%s.breaker.failure_threshold must not be negative, got %d", name, u.Breaker.FailureThreshold))
+	}
+	if u.Breaker.FailureThreshold > 0 {
+		errs = append(errs, config.ValidatePositive(name+".breaker.open_timeout", u.Breaker.OpenTimeout))
+	}
+	return errors.Join(errs...)
+}
+
+// orderSettings are the settings of orders applied to the order service.
+func (c *Config) orderSettings() (model.OrderSettings, error) {
+	policy, err := model.ParsePriceChangePolicy(c.PriceChangePolicy)
+	if err != nil {
+		return model.OrderSettings{}, err
+	}
+	return model.OrderSettings{
+		PaymentDeadline:   c.PaymentDeadline,
+		PriceChangePolicy: policy,
+	}, nil
+}
@@ -2,311 +2,313 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
-	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
-	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	apimiddleware "github.com/qyrlabs/test-backend/order/internal/api/middleware"
+	apiorderv1 "github.com/qyrlabs/test-backend/order/internal/api/order/v1"
+	apireportv1 "github.com/qyrlabs/test-backend/order/internal/api/report/v1"
+	apistreamv1 "github.com/qyrlabs/test-backend/order/internal/api/stream/v1"
+	inventoryCache "github.com/qyrlabs/test-backend/order/internal/client/cache/inventory/v1"
+	inventoryClient "github.com/qyrlabs/test-backend/order/internal/client/grpc/inventory/v1"
+	paymentClient "github.com/qyrlabs/test-backend/order/internal/client/grpc/payment/v1"
+	"github.com/qyrlabs/test-backend/order/internal/client/grpc/resilience"
+	webhookClient "github.com/qyrlabs/test-backend/order/internal/client/http/webhook/v1"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/pricing"
+	idempotencyRepository "github.com/qyrlabs/test-backend/order/internal/repository/idempotency"
+	orderRepository "github.com/qyrlabs/test-backend/order/internal/repository/order"
+	promoRepository "github.com/qyrlabs/test-backend/order/internal/repository/promo"
+	webhookRepository "github.com/qyrlabs/test-backend/order/internal/repository/webhook"
+	"github.com/qyrlabs/test-backend/order/internal/service"
+	idempotencyService "github.com/qyrlabs/test-backend/order/internal/service/idempotency"
+	orderService "github.com/qyrlabs/test-backend/order/internal/service/order"
+	promoService "github.com/qyrlabs/test-backend/order/internal/service/promo"
+	reportService "github.com/qyrlabs/test-backend/order/internal/service/report"
+	streamService "github.com/qyrlabs/test-backend/order/internal/service/stream"
+	webhookService "github.com/qyrlabs/test-backend/order/internal/service/webhook"
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
 	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
 	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
 	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
 )
 
 const (
-	httpPort                = "8080"
-	inventoryServiceAddress = "localhost:50061"
-	paymentServiceAddress   = "localhost:50062"
-
-	// Timeouts for HTTP-Server
-	requestTimeout    = 10 * time.Second
-	readHeaderTimeout = 5 * time.Second
-	shutdownTimeout   = 10 * time.Second
-)
+	// Idempotency keys are swept every idempotencyCleanupInterval.
+	idempotencyCleanupInterval = 10 * time.Minute
 
-// Repo
+	// Unpaid orders are swept every expirySweepInterval, at most expiryBatchSize at a time.
+	expirySweepInterval = 30 * time.Second
+	expiryBatchSize     = 100
 
-type OrderStorage struct {
-	mutex  sync.RWMutex
-	orders map[string]*orderv1.Order
-}
+	// Webhook events are dispatched and delivered every webhookInterval, at most webhookBatchSize
+	// at a time.
+	webhookInterval  = time.Second
+	webhookBatchSize = 100
+)
 
-func NewOrderStorage() *OrderStorage {
-	return &OrderStorage{
-		orders: make(map[string]*orderv1.Order),
+// inventoryResilience guards calls of inventory. Reads are retried, stock adjustments
+// are not idempotent.
+func inventoryResilience(upstream Upstream) resilience.Config {
+	read := resilience.Policy{
+		Timeout:        upstream.Timeout,
+		AttemptTimeout: upstream.AttemptTimeout,
+		Idempotent:     true,
+		MaxAttempts:    upstream.MaxAttempts,
+		BaseBackoff:    50 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
 	}
-}
-
-func (s *OrderStorage) GetOrder(uuid string) *orderv1.Order {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
-
-	return s.orders[uuid]
-}
-
-func (s *OrderStorage) UpdateOrder(order *orderv1.Order) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
-	order_uuid := order.GetOrderUUID().String()
-	s.orders[order_uuid] = order
-	log.Println(order)
-}
-
-// Handler
-
-type OrderHandler struct {
-	storage         *OrderStorage
-	inventoryClient inventoryv1.InventoryServiceClient
-	paymentClient   paymentv1.PaymentServiceClient
-}
-
-func NewOrderHandler(storage *OrderStorage, inventoryClient inventoryv1.InventoryServiceClient, paymentClient paymentv1.PaymentServiceClient) *OrderHandler {
-	return &OrderHandler{
-		storage:         storage,
-		inventoryClient: inventoryClient,
-		paymentClient:   paymentClient,
+	return resilience.Config{
+		Default: resilience.Policy{
+			Timeout: upstream.Timeout,
+		},
+		Methods: map[string]resilience.Policy{
+			inventoryv1.InventoryService_ListParts_FullMethodName:       read,
+			inventoryv1.InventoryService_GetShippingInfo_FullMethodName: read,
+		},
+		Breaker: resilience.BreakerPolicy{
+			FailureThreshold: upstream.Breaker.FailureThreshold,
+			OpenTimeout:      upstream.Breaker.OpenTimeout,
+		},
 	}
 }
 
-// CancelOrder implements cancelOrder operation.
-//
-// Cancels an existing order.
-//
-// POST /api/v1/orders/{order_uuid}/cancel
-func (h *OrderHandler) CancelOrder(ctx context.Context, params orderv1.CancelOrderParams) (orderv1.CancelOrderRes, error) {
-	order := h.storage.GetOrder(params.OrderUUID.String())
-
-	if order == nil {
-		return &orderv1.NotFoundError{
-			Code:    http.StatusNotFound,
-			Message: "order not found",
-		}, nil
+// paymentResilience guards calls of payment. A payment is made once per tender and
+// a refund once per refund UUID, so repeating them is safe.
+func paymentResilience(upstream Upstream) resilience.Config {
+	retried := resilience.Policy{
+		Timeout:        upstream.Timeout,
+		AttemptTimeout: upstream.AttemptTimeout,
+		Idempotent:     true,
+		MaxAttempts:    upstream.MaxAttempts,
+		BaseBackoff:    100 * time.Millisecond,
+		MaxBackoff:     500 * time.Millisecond,
 	}
-
-	if order.GetStatus() == orderv1.OrderStatusSTATUSCANCELLED {
-		return &orderv1.ConflictError{
-			Code:    http.StatusConflict,
-			Message: "order already cancelled",
-		}, nil
-	}
-
-	if order.GetStatus() == orderv1.OrderStatusSTATUSPAID {
-		return &orderv1.ConflictError{
-			Code:    http.StatusConflict,
-			Message: "order already paid and cannot be cancelled",
-		}, nil
+	return resilience.Config{
+		Default: resilience.Policy{
+			Timeout: upstream.Timeout,
+		},
+		Methods: map[string]resilience.Policy{
+			paymentv1.PaymentService_PayOrder_FullMethodName:      retried,
+			paymentv1.PaymentService_RefundPayment_FullMethodName: retried,
+		},
+		Breaker: resilience.BreakerPolicy{
+			FailureThreshold: upstream.Breaker.FailureThreshold,
+			OpenTimeout:      upstream.Breaker.OpenTimeout,
+		},
 	}
-
-	order.SetStatus(orderv1.OrderStatusSTATUSCANCELLED)
-
-	h.storage.UpdateOrder(order)
-
-	return order, nil
 }
 
-// CreateOrder implements createOrder operation.
-//
-// Creates a new order.
-//
-// POST /api/v1/orders
-func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderv1.OrderCreateRequest) (orderv1.CreateOrderRes, error) {
-	partUuids := make([]string, 0, len(req.GetPartUuids()))
-	for _, uuid := range req.GetPartUuids() {
-		partUuids = append(partUuids, uuid.String())
-	}
-
-	filteredParts, err := h.inventoryClient.ListParts(ctx, &inventoryv1.ListPartsRequest{
-		Filter: &inventoryv1.PartsFilter{
-			Uuids: partUuids,
-		},
-	})
+func initApplication(cfg *Config, pricingRules model.PricingRules, settings model.OrderSettings, statusStream service.StatusStreamService) (*grpc.ClientConn, *grpc.ClientConn, service.OrderService, service.WebhookService, service.ReportService, *orderv1.Server, http.HandlerFunc, error) {
+	inventoryConn, err := grpc.NewClient(
+		cfg.Inventory.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(resilience.UnaryClientInterceptor("inventory", inventoryResilience(cfg.Inventory))),
+	)
 	if err != nil {
-		return &orderv1.BadGatewayError{
-			Code:    http.StatusBadGateway,
-			Message: fmt.Sprintf("failed to get filtered parts: %v", err),
-		}, nil
+		return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to create inventory service grpc connection: %w", err)
 	}
 
-	if len(filteredParts.GetParts()) != len(partUuids) {
-		return &orderv1.ValidationError{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "missing specified part uuids",
-		}, nil
+	paymentConn, err := grpc.NewClient(
+		cfg.Payment.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(resilience.UnaryClientInterceptor("payment", paymentResilience(cfg.Payment))),
+	)
+	if err != nil {
+		// Cleanup: закрываем уже открытое inventoryServiceConn соединение при ошибке
+		if cerr := inventoryConn.Close(); cerr != nil {
+			log.Printf("failed to close inventory service grpc connection: %v", cerr)
+		}
+		return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to create payment service grpc connection: %w", err)
 	}
 
-	var totalPrice int64 = 0
-	for _, part := range filteredParts.GetParts() {
-		totalPrice += part.GetPriceMinor()
-	}
+	inventory := inventoryCache.NewClient(
+		inventoryClient.NewClient(inventoryv1.NewInventoryServiceClient(inventoryConn)),
+		cfg.PartCache.TTL,
+		cfg.PartCache.Size,
+	)
+	payment := paymentClient.NewClient(paymentv1.NewPaymentServiceClient(paymentConn))
+
+	// Memory is the only storage backend, see Config.Storage.
+	repo := orderRepository.NewRepository()
+	promoRepo := promoRepository.NewRepository()
+	service := orderService.NewService(repo, promoRepo, inventory, payment, statusStream, pricingRules, settings)
+	// The order repository is the outbox of webhook events.
+	webhooks := webhookService.NewService(
+		webhookRepository.NewRepository(),
+		repo,
+		webhookClient.NewClient(&http.Client{Timeout: cfg.Webhooks.Timeout}),
+		cfg.Webhooks.MaxAttempts,
+		cfg.Webhooks.RetryDelay,
+	)
+	reports := reportService.NewService(repo, inventory)
+	api := apiorderv1.NewAPI(service, promoService.NewService(promoRepo), webhooks, reports)
 
-	order := &orderv1.Order{
-		OrderUUID:       uuid.New(),
-		UserUUID:        uuid.UUID(req.GetUserUUID()),
-		PartUuids:       req.GetPartUuids(),
-		TotalPriceMinor: totalPrice,
-		Status:          orderv1.OrderStatusSTATUSPENDINGPAYMENT,
+	orderServer, err := orderv1.NewServer(api)
+	if err != nil {
+		// Cleanup: закрываем уже открытое inventoryServiceConn соединение при ошибке
+		if cerr := inventoryConn.Close(); cerr != nil {
+			log.Printf("failed to close inventory service grpc connection: %v", cerr)
+		}
+		if cerr := paymentConn.Close(); cerr != nil {
+			log.Printf("failed to close payment service grpc connection: %v", cerr)
+		}
+		return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to create order server: %w", err)
 	}
 
-	h.storage.UpdateOrder(order)
-
-	return &orderv1.OrderCreateResponse{
-		OrderUUID:       orderv1.OrderUUID(order.GetOrderUUID()),
-		TotalPriceMinor: orderv1.TotalPriceMinor(order.GetTotalPriceMinor()),
-	}, nil
+	return inventoryConn, paymentConn, service, webhooks, reports, orderServer, partCacheStats(inventory.Stats), nil
 }
 
-// GetOrderByUuid implements getOrderByUuid operation.
-//
-// Retrieves order details by UUID.
-//
-// GET /api/v1/orders/{order_uuid}
-func (h *OrderHandler) GetOrderByUuid(ctx context.Context, params orderv1.GetOrderByUuidParams) (orderv1.GetOrderByUuidRes, error) {
-	order := h.storage.GetOrder(params.OrderUUID.String())
-
-	if order == nil {
-		return &orderv1.NotFoundError{
-			Code:    http.StatusNotFound,
-			Message: "order not found",
-		}, nil
+// partCacheStats serves the part cache counters as JSON.
+func partCacheStats(stats func() inventoryCache.Stats) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(stats()); err != nil {
+			log.Printf("failed to write part cache stats: %v", err)
+		}
 	}
-
-	return order, nil
 }
 
-// PayOrder implements payOrder operation.
-//
-// Processes payment for an existing order.
-//
-// POST /api/v1/orders/{order_uuid}/pay
-func (h *OrderHandler) PayOrder(ctx context.Context, req *orderv1.OrderPayRequest, params orderv1.PayOrderParams) (orderv1.PayOrderRes, error) {
-	order := h.storage.GetOrder(params.OrderUUID.String())
-
-	if order == nil {
-		return &orderv1.NotFoundError{
-			Code:    http.StatusNotFound,
-			Message: "order not found",
-		}, nil
+// cleanupIdempotencyKeys periodically removes expired idempotency keys until ctx is done.
+func cleanupIdempotencyKeys(ctx context.Context, idempotency service.IdempotencyService) {
+	ticker := time.NewTicker(idempotencyCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := idempotency.Cleanup(ctx); err != nil {
+				log.Printf("failed to clean up idempotency keys: %v", err)
+			}
+		}
 	}
+}
 
-	if order.GetStatus() == orderv1.OrderStatusSTATUSPAID {
-		return &orderv1.ConflictError{
-			Code:    http.StatusConflict,
-			Message: "order already paid",
-		}, nil
+// expireOrders periodically cancels orders not paid before their deadline until ctx is done.
+// Sweeps are not coordinated between instances, there is no store they share. Expiring an
+// order is a compare-and-swap on its version, so a sweep racing a payment or another sweep
+// skips the order.
+func expireOrders(ctx context.Context, orders service.OrderService) {
+	ticker := time.NewTicker(expirySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := orders.Expire(ctx, expiryBatchSize)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("failed to expire orders: %v", err)
+			}
+			if expired > 0 {
+				log.Printf("expired %d unpaid orders", expired)
+			}
+		}
 	}
+}
 
-	if order.GetStatus() == orderv1.OrderStatusSTATUSCANCELLED {
-		return &orderv1.ConflictError{
-			Code:    http.StatusConflict,
-			Message: "order cancelled",
-		}, nil
+// deliverWebhooks periodically dispatches outbox events and delivers them to webhooks until ctx is done.
+// The outbox is kept in the memory of the instance, so only the instance that stored an event sends it.
+func deliverWebhooks(ctx context.Context, webhooks service.WebhookService) {
+	ticker := time.NewTicker(webhookInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := webhooks.Dispatch(ctx, webhookBatchSize); err != nil && ctx.Err() == nil {
+				log.Printf("failed to dispatch webhook events: %v", err)
+			}
+			if _, err := webhooks.Deliver(ctx, webhookBatchSize); err != nil && ctx.Err() == nil {
+				log.Printf("failed to deliver webhook events: %v", err)
+			}
+		}
 	}
+}
 
-	paymentMethod, ok := paymentv1.PaymentMethod_value[string(req.GetPaymentMethod())]
-	if !ok {
-		return &orderv1.ValidationError{
-			Code:    http.StatusUnprocessableEntity,
-			Message: "invalid payment method",
-		}, nil
+func main() {
+	stateMachineDOT := flag.Bool("state-machine-dot", false, "write the order state machine as a Graphviz digraph to stdout and exit")
+	loader := config.NewLoader("ORDER", defaultConfig)
+	loader.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if *stateMachineDOT {
+		if err := orderService.NewStateMachine().WriteDOT(os.Stdout); err != nil {
+			log.Fatalf("failed to write state machine: %v", err)
+		}
+		return
 	}
 
-	payOrderResponse, err := h.paymentClient.PayOrder(ctx, &paymentv1.PayOrderRequest{
-		OrderUuid:     order.GetOrderUUID().String(),
-		UserUuid:      order.GetUserUUID().String(),
-		PaymentMethod: paymentv1.PaymentMethod(paymentMethod),
-	})
+	cfg, err := loader.Load()
 	if err != nil {
-		return &orderv1.BadGatewayError{
-			Code:    http.StatusBadGateway,
-			Message: fmt.Sprintf("failed to pay order: %v", err),
-		}, nil
+		log.Fatalf("failed to load config: %v", err)
 	}
-
-	transactionUuid := uuid.MustParse(payOrderResponse.GetTransactionUuid())
-
-	order.SetStatus(orderv1.OrderStatusSTATUSPAID)
-	order.SetTransactionUUID(orderv1.NewOptUUID(transactionUuid))
-
-	h.storage.UpdateOrder(order)
-
-	return &orderv1.OrderPayResponse{
-		TransactionUUID: transactionUuid,
-	}, nil
-}
-
-// NewError creates *GenericErrorStatusCode from error returned by handler.
-//
-// Used for common default response.
-func (h *OrderHandler) NewError(ctx context.Context, err error) *orderv1.GenericErrorStatusCode {
-	return &orderv1.GenericErrorStatusCode{
-		StatusCode: http.StatusInternalServerError,
-		Response: orderv1.GenericError{
-			Code:    orderv1.NewOptInt(http.StatusInternalServerError),
-			Message: orderv1.NewOptString(err.Error()),
-		},
-	}
-}
-
-func initApplication() (*grpc.ClientConn, *grpc.ClientConn, *orderv1.Server, error) {
-	inventoryConn, err := grpc.NewClient(
-		inventoryServiceAddress,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	effective, err := config.Format(cfg)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to create inventory service grpc connection: %w", err)
+		log.Fatal(err)
+	}
+	if loader.PrintOnly() {
+		fmt.Print(effective)
+		return
 	}
+	log.Printf("effective config:\n%s", effective)
 
-	paymentConn, err := grpc.NewClient(
-		paymentServiceAddress,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		// Cleanup: закрываем уже открытое inventoryServiceConn соединение при ошибке
-		if cerr := inventoryConn.Close(); cerr != nil {
-			log.Printf("failed to close inventory service grpc connection: %v", cerr)
+	pricingRules := pricing.DefaultRules()
+	if cfg.PricingRules != "" {
+		rules, err := pricing.LoadRules(cfg.PricingRules)
+		if err != nil {
+			log.Fatalf("failed to load pricing rules: %v", err)
 		}
-		return nil, nil, nil, fmt.Errorf("failed to create payment service grpc connection: %w", err)
+		pricingRules = rules
 	}
 
-	inventoryClient := inventoryv1.NewInventoryServiceClient(inventoryConn)
-	paymentClient := paymentv1.NewPaymentServiceClient(paymentConn)
-
-	storage := NewOrderStorage()
-	orderHandler := NewOrderHandler(storage, inventoryClient, paymentClient)
-
-	orderServer, err := orderv1.NewServer(orderHandler)
+	settings, err := cfg.orderSettings()
 	if err != nil {
-		// Cleanup: закрываем уже открытое inventoryServiceConn соединение при ошибке
-		if cerr := inventoryConn.Close(); cerr != nil {
-			log.Printf("failed to close inventory service grpc connection: %v", cerr)
-		}
-		if cerr := paymentConn.Close(); cerr != nil {
-			log.Printf("failed to close payment service grpc connection: %v", cerr)
-		}
-		return nil, nil, nil, fmt.Errorf("failed to create order server: %w", err)
+		log.Fatalf("failed to parse price change policy: %v", err)
 	}
 
-	return inventoryConn, paymentConn, orderServer, nil
-}
+	statusStream := streamService.NewService(cfg.StatusReplaySize)
 
-func main() {
-	inventoryConn, paymentConn, orderServer, err := initApplication()
+	inventoryConn, paymentConn, orders, webhooks, reports, orderServer, cacheStats, err := initApplication(cfg, pricingRules, settings, statusStream)
 	if err != nil {
 		log.Fatalf("failed to init application: %v", err)
 	}
 
+	// Settings safe to change at runtime are read from current and reloaded on SIGHUP.
+	var current atomic.Pointer[Config]
+	current.Store(cfg)
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	defer stopReload()
+	go loader.Watch(reloadCtx, cfg, func(next *Config) {
+		settings, err := next.orderSettings()
+		if err != nil {
+			log.Printf("failed to parse price change policy: %v", err)
+			return
+		}
+		orders.Reconfigure(settings)
+		current.Store(next)
+	})
+
 	defer func() {
 		if cerr := inventoryConn.Close(); cerr != nil {
 			log.Printf("failed to close inventory service grpc connection: %v", cerr)
@@ -319,19 +321,44 @@ func main() {
 		}
 	}()
 
+	// Background jobs are stopped after the http server so that no request is cut off.
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+
+	idempotency := idempotencyService.NewService(idempotencyRepository.NewRepository(), cfg.IdempotencyRetention)
+	background.Go(func() { cleanupIdempotencyKeys(backgroundCtx, idempotency) })
+
+	background.Go(func() { expireOrders(backgroundCtx, orders) })
+	background.Go(func() { deliverWebhooks(backgroundCtx, webhooks) })
+
 	router := chi.NewRouter()
 
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
-	router.Use(middleware.Timeout(requestTimeout))
 
-	router.Mount("/", orderServer)
+	// Event streams stay open, so they are routed around the request timeout.
+	streams := apistreamv1.NewAPI(orders, statusStream)
+	router.Get("/api/v1/orders/events", streams.UserOrderEvents)
+	router.Get("/api/v1/orders/{order_uuid}/events", streams.OrderEvents)
+
+	// Part cache counters only, runtime internals are not exposed.
+	router.Get("/debug/part-cache", cacheStats)
+
+	router.Group(func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.HTTP.RequestTimeout))
+		r.Use(apimiddleware.Idempotency(idempotency))
+
+		r.Get("/api/v1/reports/sales.csv", apireportv1.NewAPI(reports).SalesCSV)
+		r.Mount("/", orderServer)
+	})
 
 	server := &http.Server{
-		Addr:              net.JoinHostPort("localhost", httpPort),
+		Addr:              cfg.HTTP.Addr,
 		Handler:           router,
-		ReadHeaderTimeout: readHeaderTimeout,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
 	}
+	// Shutdown waits for open connections, event streams are ended so that it does not time out.
+	server.RegisterOnShutdown(statusStream.Close)
 
 	go func() {
 		log.Printf("http server listening on %s\n", server.Addr)
@@ -348,7 +375,7 @@ func main() {
 
 	log.Println("Shutting down http server...")
 
-	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), current.Load().ShutdownTimeout)
 	defer cancel()
 
 	err = server.Shutdown(ctx)
@@ -357,4 +384,9 @@ func main() {
 	}
 
 	log.Println("http server stopped")
+
+	stopBackground()
+	background.Wait()
+
+	log.Println("background jobs stopped")
 }
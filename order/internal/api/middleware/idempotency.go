@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/service"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key of a mutating request.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed from storage.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// Keys longer than this are left to API validation.
+	maxIdempotencyKeyLength = 255
+	// Request bodies are buffered to fingerprint them.
+	maxIdempotentBodyBytes = 1 << 20
+)
+
+// Idempotency replays stored responses of mutating requests repeated with the
+// same Idempotency-Key header. A key reused with a different method, path or
+// body is rejected with 422, and a retry of a request still in progress with 409.
+// Responses with 5xx status are not stored, so such requests can be retried.
+func Idempotency(idempotencyService service.IdempotencyService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || len(key) > maxIdempotencyKeyLength || !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+			if err != nil {
+				writeError(w, &orderv1.ValidationError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: "failed to read request body: " + err.Error(),
+				}, http.StatusUnprocessableEntity)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			stored, err := idempotencyService.Begin(r.Context(), key, fingerprint(r, body))
+			switch {
+			case errors.Is(err, model.ErrIdempotencyKeyInUse):
+				writeError(w, &orderv1.ConflictError{
+					Code:    http.StatusConflict,
+					Message: err.Error(),
+				}, http.StatusConflict)
+				return
+			case errors.Is(err, model.ErrIdempotencyKeyReused):
+				writeError(w, &orderv1.ValidationError{
+					Code:    http.StatusUnprocessableEntity,
+					Message: err.Error(),
+				}, http.StatusUnprocessableEntity)
+				return
+			case err != nil:
+				log.Printf("failed to reserve idempotency key: %v", err)
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			case stored != nil:
+				replay(w, stored)
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w}
+			// Runs on panics too, so that a crashed request does not hold the key.
+			defer func() {
+				ctx := context.WithoutCancel(r.Context())
+				if recorder.statusCode == 0 || recorder.statusCode >= http.StatusInternalServerError {
+					if err := idempotencyService.Abort(ctx, key); err != nil {
+						log.Printf("failed to release idempotency key: %v", err)
+					}
+					return
+				}
+				err := idempotencyService.Complete(ctx, key, model.IdempotentResponse{
+					StatusCode:  recorder.statusCode,
+					ContentType: recorder.Header().Get("Content-Type"),
+					ETag:        recorder.Header().Get("ETag"),
+					Body:        recorder.body.Bytes(),
+				})
+				if err != nil {
+					log.Printf("failed to store idempotent response: %v", err)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+		})
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, response *model.IdempotentResponse) {
+	if response.ContentType != "" {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+	if response.ETag != "" {
+		w.Header().Set("ETag", response.ETag)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.StatusCode)
+	if _, err := w.Write(response.Body); err != nil {
+		log.Printf("failed to write replayed response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, body interface{ MarshalJSON() ([]byte, error) }, statusCode int) {
+	data, err := body.MarshalJSON()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		log.Printf("failed to write error response: %v", err)
+	}
+}
+
+// responseRecorder passes the response through and keeps a copy of it.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/service"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+var _ orderv1.Handler = &api{}
+
+type api struct {
+	orderService     service.OrderService
+	promoCodeService service.PromoCodeService
+	webhookService   service.WebhookService
+	reportService    service.ReportService
+}
+
+func NewAPI(orderService service.OrderService, promoCodeService service.PromoCodeService, webhookService service.WebhookService, reportService service.ReportService) *api {
+	return &api{
+		orderService:     orderService,
+		promoCodeService: promoCodeService,
+		webhookService:   webhookService,
+		reportService:    reportService,
+	}
+}
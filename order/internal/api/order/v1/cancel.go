@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// CancelOrder implements cancelOrder operation.
+//
+// Cancels an existing order.
+//
+// POST /api/v1/orders/{order_uuid}/cancel
+func (a *api) CancelOrder(ctx context.Context, params orderv1.CancelOrderParams) (orderv1.CancelOrderRes, error) {
+	order, err := a.orderService.Cancel(ctx, params.OrderUUID.String(), converter.ToModelIfMatch(params.IfMatch))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrTransitionNotAllowed), errors.Is(err, model.ErrOrderChanged):
+			return &orderv1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrVersionMismatch):
+			return &orderv1.PreconditionFailedError{
+				Code:    http.StatusPreconditionFailed,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIOrderHeaders(order), nil
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// CreateOrder implements createOrder operation.
+//
+// Creates a new order.
+//
+// POST /api/v1/orders
+func (a *api) CreateOrder(ctx context.Context, req *orderv1.OrderCreateRequest, params orderv1.CreateOrderParams) (orderv1.CreateOrderRes, error) {
+	order, err := a.orderService.Create(ctx, converter.ToModelOrderRequest(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartsNotFound), errors.Is(err, model.ErrInsufficientStock),
+			errors.Is(err, model.ErrPromoCodeNotFound), errors.Is(err, model.ErrPromoCodeNotApplicable),
+			errors.Is(err, model.ErrUnsupportedCountry):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamRejected):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			return &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstream):
+			return &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &orderv1.OrderCreateResponse{
+		OrderUUID:       orderv1.OrderUUID(uuid.MustParse(order.OrderUuid)),
+		TotalPriceMinor: orderv1.TotalPriceMinor(order.TotalPriceMinor),
+	}, nil
+}
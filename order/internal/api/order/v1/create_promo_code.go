@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// CreatePromoCode implements createPromoCode operation.
+//
+// Creates a percentage or fixed-amount promo code.
+//
+// POST /api/v1/promo-codes
+func (a *api) CreatePromoCode(ctx context.Context, req *orderv1.PromoCodeCreateRequest, params orderv1.CreatePromoCodeParams) (orderv1.CreatePromoCodeRes, error) {
+	promo, err := a.promoCodeService.Create(ctx, converter.ToModelPromoCode(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPromoCodeExists):
+			return &orderv1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrInvalidPromoCode):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIPromoCode(promo), nil
+}
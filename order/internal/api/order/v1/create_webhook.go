@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// CreateWebhook implements createWebhook operation.
+//
+// Subscribes an HTTP endpoint to order events. The signing secret is returned only in this response.
+//
+// POST /api/v1/webhooks
+func (a *api) CreateWebhook(ctx context.Context, req *orderv1.WebhookCreateRequest, params orderv1.CreateWebhookParams) (orderv1.CreateWebhookRes, error) {
+	webhook, err := a.webhookService.Create(ctx, converter.ToModelWebhook(req))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidWebhook) {
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	apiWebhook := converter.ToAPIWebhook(webhook)
+	apiWebhook.Secret = orderv1.NewOptString(webhook.Secret)
+	return apiWebhook, nil
+}
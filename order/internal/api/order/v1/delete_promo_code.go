@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// DeletePromoCode implements deletePromoCode operation.
+//
+// Deletes a promo code. Orders that already use it keep their discount.
+//
+// DELETE /api/v1/promo-codes/{code}
+func (a *api) DeletePromoCode(ctx context.Context, params orderv1.DeletePromoCodeParams) (orderv1.DeletePromoCodeRes, error) {
+	if err := a.promoCodeService.Delete(ctx, params.Code); err != nil {
+		if errors.Is(err, model.ErrPromoCodeNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &orderv1.DeletePromoCodeNoContent{}, nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// DeleteWebhook implements deleteWebhook operation.
+//
+// Deletes a webhook subscription and its pending deliveries. Dead letters are kept.
+//
+// DELETE /api/v1/webhooks/{webhook_uuid}
+func (a *api) DeleteWebhook(ctx context.Context, params orderv1.DeleteWebhookParams) (orderv1.DeleteWebhookRes, error) {
+	if err := a.webhookService.Delete(ctx, params.WebhookUUID.String()); err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &orderv1.DeleteWebhookNoContent{}, nil
+}
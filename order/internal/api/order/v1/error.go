@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// NewError creates *GenericErrorStatusCode from error returned by handler.
+//
+// Used for common default response.
+func (a *api) NewError(ctx context.Context, err error) *orderv1.GenericErrorStatusCode {
+	return &orderv1.GenericErrorStatusCode{
+		StatusCode: http.StatusInternalServerError,
+		Response: orderv1.GenericError{
+			Code:    orderv1.NewOptInt(http.StatusInternalServerError),
+			Message: orderv1.NewOptString(err.Error()),
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// GetOrderByUuid implements getOrderByUuid operation.
+//
+// Retrieves order details by UUID, with part details from inventory embedded on expand=parts.
+//
+// GET /api/v1/orders/{order_uuid}
+func (a *api) GetOrderByUuid(ctx context.Context, params orderv1.GetOrderByUuidParams) (orderv1.GetOrderByUuidRes, error) {
+	order, err := a.orderService.Get(ctx, params.OrderUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	res := converter.ToAPIOrderHeaders(order)
+	if slices.Contains(params.Expand, orderv1.OrderExpandParts) {
+		converter.ExpandAPIOrderParts(&res.Response, a.orderService.ExpandParts(ctx, []*model.Order{order}))
+	}
+
+	return res, nil
+}
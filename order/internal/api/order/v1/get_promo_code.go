@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// GetPromoCode implements getPromoCode operation.
+//
+// Retrieves a promo code with its usage.
+//
+// GET /api/v1/promo-codes/{code}
+func (a *api) GetPromoCode(ctx context.Context, params orderv1.GetPromoCodeParams) (orderv1.GetPromoCodeRes, error) {
+	promo, err := a.promoCodeService.Get(ctx, params.Code)
+	if err != nil {
+		if errors.Is(err, model.ErrPromoCodeNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIPromoCode(promo), nil
+}
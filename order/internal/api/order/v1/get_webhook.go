@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// GetWebhook implements getWebhook operation.
+//
+// Retrieves a webhook subscription without its secret.
+//
+// GET /api/v1/webhooks/{webhook_uuid}
+func (a *api) GetWebhook(ctx context.Context, params orderv1.GetWebhookParams) (orderv1.GetWebhookRes, error) {
+	webhook, err := a.webhookService.Get(ctx, params.WebhookUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIWebhook(webhook), nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Retrieves every status transition of the order with its time, actor and reason.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (a *api) GetOrderHistory(ctx context.Context, params orderv1.GetOrderHistoryParams) (orderv1.GetOrderHistoryRes, error) {
+	history, err := a.orderService.History(ctx, params.OrderUUID.String())
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &orderv1.OrderHistoryResponse{
+		OrderUUID:   orderv1.OrderUUID(params.OrderUUID),
+		Transitions: converter.ToAPIStatusTransitions(history),
+	}, nil
+}
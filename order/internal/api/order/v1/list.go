@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// ListOrders implements listOrders operation.
+//
+// Lists orders matching filters, page by page, with part details from inventory embedded on
+// expand=parts.
+//
+// GET /api/v1/orders
+func (a *api) ListOrders(ctx context.Context, params orderv1.ListOrdersParams) (orderv1.ListOrdersRes, error) {
+	query, err := converter.ToModelOrdersQuery(params)
+	if err != nil {
+		return &orderv1.ValidationError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, err := a.orderService.List(ctx, query)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCursor) {
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	res := converter.ToAPIOrderListResponse(page)
+	if slices.Contains(params.Expand, orderv1.OrderExpandParts) {
+		// One inventory request for the parts of the whole page.
+		expansion := a.orderService.ExpandParts(ctx, page.Orders)
+		for i := range res.Orders {
+			converter.ExpandAPIOrderParts(&res.Orders[i], expansion)
+		}
+		if expansion.Partial {
+			res.ExpandPartial = []orderv1.OrderExpand{orderv1.OrderExpandParts}
+		}
+	}
+
+	return res, nil
+}
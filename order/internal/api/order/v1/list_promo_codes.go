@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// ListPromoCodes implements listPromoCodes operation.
+//
+// Lists all promo codes in creation order.
+//
+// GET /api/v1/promo-codes
+func (a *api) ListPromoCodes(ctx context.Context) (*orderv1.PromoCodeListResponse, error) {
+	promoCodes, err := a.promoCodeService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.PromoCodeListResponse{
+		PromoCodes: converter.ToAPIPromoCodes(promoCodes),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// ListWebhookDeadLetters implements listWebhookDeadLetters operation.
+//
+// Lists events that could not be delivered after all retries, most recent first.
+//
+// GET /api/v1/webhooks/dead-letters
+func (a *api) ListWebhookDeadLetters(ctx context.Context) (*orderv1.WebhookDeadLetterListResponse, error) {
+	deadLetters, err := a.webhookService.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.WebhookDeadLetterListResponse{
+		DeadLetters: converter.ToAPIWebhookDeliveries(deadLetters),
+	}, nil
+}
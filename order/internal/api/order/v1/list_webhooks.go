@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// ListWebhooks implements listWebhooks operation.
+//
+// Lists all webhook subscriptions in creation order.
+//
+// GET /api/v1/webhooks
+func (a *api) ListWebhooks(ctx context.Context) (*orderv1.WebhookListResponse, error) {
+	webhooks, err := a.webhookService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderv1.WebhookListResponse{
+		Webhooks: converter.ToAPIWebhooks(webhooks),
+	}, nil
+}
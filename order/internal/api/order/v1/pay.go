@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// PayOrder implements payOrder operation.
+//
+// Processes payment for an existing order, either fully with one payment method or split
+// into tenders charged in order. While tenders are charged the order is partially paid.
+// If a tender fails, the tenders already charged are refunded and the order returns to
+// pending payment. Before payment the ordered parts are checked against inventory, and
+// discontinued, out of stock or, depending on the price change policy, repriced parts
+// are reported in the conflict.
+//
+// POST /api/v1/orders/{order_uuid}/pay
+func (a *api) PayOrder(ctx context.Context, req *orderv1.OrderPayRequest, params orderv1.PayOrderParams) (orderv1.PayOrderRes, error) {
+	order, err := a.orderService.Pay(ctx, params.OrderUUID.String(), converter.ToModelPayRequest(req), converter.ToModelIfMatch(params.IfMatch))
+	if err != nil {
+		var changedErr *model.ItemsChangedError
+		switch {
+		case errors.As(err, &changedErr):
+			return converter.ToAPIPayConflictError(http.StatusConflict, changedErr), nil
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrTransitionNotAllowed), errors.Is(err, model.ErrOrderAlreadyPaid),
+			errors.Is(err, model.ErrInsufficientStock), errors.Is(err, model.ErrPaymentDeadline),
+			errors.Is(err, model.ErrOrderChanged):
+			conflict := orderv1.NewConflictErrorPayOrderConflict(orderv1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			})
+			return &conflict, nil
+		case errors.Is(err, model.ErrVersionMismatch):
+			return &orderv1.PreconditionFailedError{
+				Code:    http.StatusPreconditionFailed,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrInvalidPaymentMethod), errors.Is(err, model.ErrInvalidTenders),
+			errors.Is(err, model.ErrPromoCodeNotFound), errors.Is(err, model.ErrPromoCodeNotApplicable):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamRejected):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			return &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstream):
+			return &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIOrderPayResponse(order), nil
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// RefundOrder implements refundOrder operation.
+//
+// Refunds a paid order fully or partially and returns refunded parts to inventory.
+//
+// POST /api/v1/orders/{order_uuid}/refund
+func (a *api) RefundOrder(ctx context.Context, req *orderv1.OrderRefundRequest, params orderv1.RefundOrderParams) (orderv1.RefundOrderRes, error) {
+	order, err := a.orderService.Refund(ctx, params.OrderUUID.String(), converter.ToModelRefundRequest(req), converter.ToModelIfMatch(params.IfMatch))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrTransitionNotAllowed), errors.Is(err, model.ErrOrderChanged):
+			return &orderv1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrVersionMismatch):
+			return &orderv1.PreconditionFailedError{
+				Code:    http.StatusPreconditionFailed,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrInvalidRefund):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamRejected):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			return &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstream):
+			return &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIOrderHeaders(order), nil
+}
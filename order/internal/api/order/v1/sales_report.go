@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// GetSalesReport implements getSalesReport operation.
+//
+// Aggregates orders created in the period into revenue grouped by the chosen dimensions,
+// with conversion of created orders to paid.
+//
+// GET /api/v1/reports/sales
+func (a *api) GetSalesReport(ctx context.Context, params orderv1.GetSalesReportParams) (orderv1.GetSalesReportRes, error) {
+	report, err := a.reportService.Sales(ctx, converter.ToModelSalesQuery(params))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidSalesQuery):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamRejected):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			return &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstream):
+			return &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPISalesReport(report), nil
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// UpdateOrder implements updateOrder operation.
+//
+// Adds, removes or changes the quantity of parts of an order pending payment.
+//
+// PATCH /api/v1/orders/{order_uuid}
+func (a *api) UpdateOrder(ctx context.Context, req *orderv1.OrderUpdateRequest, params orderv1.UpdateOrderParams) (orderv1.UpdateOrderRes, error) {
+	order, err := a.orderService.Update(ctx, params.OrderUUID.String(), converter.ToModelOrderUpdateRequest(req), converter.ToModelIfMatch(params.IfMatch))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrTransitionNotAllowed), errors.Is(err, model.ErrPaymentDeadline),
+			errors.Is(err, model.ErrOrderChanged):
+			return &orderv1.ConflictError{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrVersionMismatch):
+			return &orderv1.PreconditionFailedError{
+				Code:    http.StatusPreconditionFailed,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrPartsNotFound), errors.Is(err, model.ErrInsufficientStock),
+			errors.Is(err, model.ErrEmptyOrder), errors.Is(err, model.ErrPromoCodeNotFound),
+			errors.Is(err, model.ErrPromoCodeNotApplicable):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamRejected):
+			return &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			return &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, nil
+		case errors.Is(err, model.ErrUpstream):
+			return &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return converter.ToAPIOrderHeaders(order), nil
+}
@@ -0,0 +1,33 @@
+// Package v1 exports sales reports as CSV. The export is a plain chi handler,
+// the generated order API cannot stream.
+package v1
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+type api struct {
+	reportService service.ReportService
+}
+
+func NewAPI(reportService service.ReportService) *api {
+	return &api{
+		reportService: reportService,
+	}
+}
+
+func writeError(w http.ResponseWriter, body interface{ MarshalJSON() ([]byte, error) }, statusCode int) {
+	data, err := body.MarshalJSON()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		log.Printf("failed to write error response: %v", err)
+	}
+}
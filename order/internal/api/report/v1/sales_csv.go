@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// Rows are flushed to the client flushRows at a time.
+const flushRows = 100
+
+// SalesCSV exports the sales report with the parameters of getSalesReport as
+// CSV. The columns are the dimensions the report is grouped by followed by
+// orders_paid, units, revenue_minor and refunded_minor.
+//
+// GET /api/v1/reports/sales.csv
+func (a *api) SalesCSV(w http.ResponseWriter, r *http.Request) {
+	params, err := salesReportParams(r.URL.Query())
+	if err != nil {
+		writeError(w, &orderv1.ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	report, err := a.reportService.Sales(r.Context(), converter.ToModelSalesQuery(params))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidSalesQuery):
+			writeError(w, &orderv1.ValidationError{
+				Code:    http.StatusUnprocessableEntity,
+				Message: err.Error(),
+			}, http.StatusUnprocessableEntity)
+		case errors.Is(err, model.ErrUpstreamUnavailable):
+			writeError(w, &orderv1.ServiceUnavailableError{
+				Code:    http.StatusServiceUnavailable,
+				Message: err.Error(),
+			}, http.StatusServiceUnavailable)
+		case errors.Is(err, model.ErrUpstream):
+			writeError(w, &orderv1.BadGatewayError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			}, http.StatusBadGateway)
+		default:
+			writeError(w, &orderv1.GenericError{
+				Code:    orderv1.NewOptInt(http.StatusInternalServerError),
+				Message: orderv1.NewOptString(err.Error()),
+			}, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="sales.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header(report.Query.GroupBy)); err != nil {
+		return
+	}
+	for i, row := range report.Rows {
+		if err := cw.Write(record(report.Query.GroupBy, row)); err != nil {
+			return
+		}
+		if (i+1)%flushRows == 0 {
+			cw.Flush()
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		log.Printf("failed to write sales report: %v", err)
+	}
+}
+
+// salesReportParams decodes the query of getSalesReport.
+func salesReportParams(query url.Values) (orderv1.GetSalesReportParams, error) {
+	var params orderv1.GetSalesReportParams
+
+	for name, value := range map[string]*time.Time{"from": &params.From, "to": &params.To} {
+		t, err := time.Parse(time.RFC3339, query.Get(name))
+		if err != nil {
+			return params, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		*value = t
+	}
+
+	for _, value := range query["group_by"] {
+		dimension := orderv1.SalesDimension(value)
+		if err := dimension.Validate(); err != nil {
+			return params, fmt.Errorf("invalid group_by: %w", err)
+		}
+		params.GroupBy = append(params.GroupBy, dimension)
+	}
+
+	return params, nil
+}
+
+func header(groupBy []model.SalesDimension) []string {
+	columns := make([]string, 0, len(groupBy)+4)
+	for _, dimension := range groupBy {
+		columns = append(columns, dimension.String())
+	}
+	return append(columns, "orders_paid", "units", "revenue_minor", "refunded_minor")
+}
+
+// record formats the row as in the JSON report, absent values are empty.
+func record(groupBy []model.SalesDimension, row model.SalesRow) []string {
+	values := make([]string, 0, len(groupBy)+4)
+	for _, dimension := range groupBy {
+		var value string
+		switch dimension {
+		case model.SalesDimensionDay:
+			value = row.Day.Format(time.DateOnly)
+		case model.SalesDimensionPaymentMethod:
+			if row.PaymentMethod != model.PaymentMethodUnspecified {
+				value = string(converter.ToAPIPaymentMethod(row.PaymentMethod))
+			}
+		case model.SalesDimensionCategory:
+			if row.Category != model.PartCategoryUnspecified {
+				value = string(converter.ToAPIPartCategory(row.Category))
+			}
+		case model.SalesDimensionManufacturer:
+			value = row.Manufacturer
+		}
+		values = append(values, value)
+	}
+	return append(values,
+		strconv.FormatInt(row.OrdersPaid, 10),
+		strconv.FormatInt(row.Units, 10),
+		strconv.FormatInt(row.RevenueMinor, 10),
+		strconv.FormatInt(row.RefundedMinor, 10),
+	)
+}
@@ -0,0 +1,138 @@
+// Package v1 serves live order status changes as Server-Sent Events. The
+// streams are plain chi handlers, the generated order API cannot stream.
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/service"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+const (
+	// LastEventIDHeader carries the ID of the last change a reconnecting client received.
+	LastEventIDHeader = "Last-Event-ID"
+
+	// heartbeatInterval keeps idle streams open through proxies.
+	heartbeatInterval = 15 * time.Second
+	// reconnectDelay is the delay clients wait before reconnecting, in milliseconds.
+	reconnectDelay = 3000
+)
+
+type api struct {
+	orderService service.OrderService
+	statusStream service.StatusStreamService
+}
+
+func NewAPI(orderService service.OrderService, statusStream service.StatusStreamService) *api {
+	return &api{
+		orderService: orderService,
+		statusStream: statusStream,
+	}
+}
+
+// statusEvent is the data of a "status" event.
+type statusEvent struct {
+	OrderUUID  string                    `json:"order_uuid"`
+	UserUUID   string                    `json:"user_uuid"`
+	Transition *orderv1.StatusTransition `json:"transition"`
+}
+
+// stream writes changes as "status" events with heartbeats in between until
+// the client disconnects or the channel is closed. Events in first are written
+// before the changes, and an empty event with lastID sets the resume point of
+// clients that receive no event before reconnecting.
+func stream(w http.ResponseWriter, r *http.Request, changes <-chan model.StatusChange, lastID uint64, first ...model.StatusChange) {
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\nid: %d\n\n", reconnectDelay, lastID); err != nil {
+		return
+	}
+	for _, change := range first {
+		if err := writeChange(w, change); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		log.Printf("failed to flush event stream: %v", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case change, ok := <-changes:
+			if !ok {
+				return
+			}
+			if err := writeChange(w, change); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeChange(w http.ResponseWriter, change model.StatusChange) error {
+	data, err := json.Marshal(statusEvent{
+		OrderUUID:  change.OrderUuid,
+		UserUUID:   change.UserUuid,
+		Transition: &converter.ToAPIStatusTransitions([]model.StatusTransition{change.Transition})[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: status\ndata: %s\n\n", change.ID, data)
+	return err
+}
+
+// lastEventID returns the Last-Event-ID of a reconnecting client and whether it was sent.
+func lastEventID(r *http.Request) (uint64, bool, error) {
+	header := r.Header.Get(LastEventIDHeader)
+	if header == "" {
+		return 0, false, nil
+	}
+
+	id, err := strconv.ParseUint(header, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s header: %w", LastEventIDHeader, err)
+	}
+	return id, true, nil
+}
+
+func writeError(w http.ResponseWriter, body interface{ MarshalJSON() ([]byte, error) }, statusCode int) {
+	data, err := body.MarshalJSON()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		log.Printf("failed to write error response: %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// OrderEvents streams status changes of one order. A new stream starts with
+// the current status of the order, a resumed one with the changes after
+// Last-Event-ID that are still buffered.
+//
+// GET /api/v1/orders/{order_uuid}/events
+func (a *api) OrderEvents(w http.ResponseWriter, r *http.Request) {
+	orderUuid, err := uuid.Parse(chi.URLParam(r, "order_uuid"))
+	if err != nil {
+		writeError(w, &orderv1.ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: "invalid order uuid: " + err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	lastID, resume, err := lastEventID(r)
+	if err != nil {
+		writeError(w, &orderv1.ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	filter := model.StatusChangeFilter{OrderUuid: orderUuid.String()}
+	var changes <-chan model.StatusChange
+	if resume {
+		changes, _ = a.statusStream.Resume(r.Context(), filter, lastID)
+	} else {
+		changes, lastID = a.statusStream.Subscribe(r.Context(), filter)
+	}
+
+	// Read after subscribing, so that no change is missed between the two.
+	order, err := a.orderService.Get(r.Context(), filter.OrderUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			writeError(w, &orderv1.NotFoundError{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			}, http.StatusNotFound)
+			return
+		}
+		writeError(w, &orderv1.GenericError{
+			Code:    orderv1.NewOptInt(http.StatusInternalServerError),
+			Message: orderv1.NewOptString(err.Error()),
+		}, http.StatusInternalServerError)
+		return
+	}
+
+	var current []model.StatusChange
+	if !resume && len(order.History) > 0 {
+		current = append(current, model.StatusChange{
+			ID:         lastID,
+			OrderUuid:  order.OrderUuid,
+			UserUuid:   order.UserUuid,
+			Transition: order.History[len(order.History)-1],
+		})
+	}
+
+	stream(w, r, changes, lastID, current...)
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// UserOrderEvents streams status changes of all orders of the user, including
+// orders placed after the stream is opened. A resumed stream starts with the
+// changes after Last-Event-ID that are still buffered.
+//
+// GET /api/v1/orders/events?user_uuid={user_uuid}
+func (a *api) UserOrderEvents(w http.ResponseWriter, r *http.Request) {
+	userUuid, err := uuid.Parse(r.URL.Query().Get("user_uuid"))
+	if err != nil {
+		writeError(w, &orderv1.ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: "invalid user_uuid: " + err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+	lastID, resume, err := lastEventID(r)
+	if err != nil {
+		writeError(w, &orderv1.ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+		return
+	}
+
+	filter := model.StatusChangeFilter{UserUuid: userUuid.String()}
+	var changes <-chan model.StatusChange
+	if resume {
+		changes, _ = a.statusStream.Resume(r.Context(), filter, lastID)
+	} else {
+		changes, lastID = a.statusStream.Subscribe(r.Context(), filter)
+	}
+
+	stream(w, r, changes, lastID)
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// AdjustStock adjusts the stock in inventory and drops the adjusted parts from
+// the cache, as their stock changed.
+func (c *client) AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) error {
+	err := c.next.AdjustStock(ctx, adjustments)
+
+	// Dropped even on failure, a failed request may still have been applied.
+	uuids := make([]string, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		uuids = append(uuids, adjustment.PartUuid)
+	}
+	c.Invalidate(uuids...)
+
+	return err
+}
@@ -0,0 +1,126 @@
+// Package v1 caches inventory parts in front of the inventory client, so that
+// orders are priced without waiting for inventory.
+package v1
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	def "github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+var _ def.InventoryClient = &client{}
+
+// Stats counts the part lookups served by the cache.
+type Stats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	// Parts cached, including parts not in inventory.
+	Size int `json:"size"`
+}
+
+// entry is a cached part, nil for a part not in inventory.
+type entry struct {
+	uuid      string
+	part      *model.Part
+	expiresAt time.Time
+}
+
+type client struct {
+	next def.InventoryClient
+	ttl  time.Duration
+	size int
+	// Lookups of the same missing parts share one inventory request.
+	lookups singleflight.Group
+
+	mu sync.Mutex
+	// Entries by part UUID, the least recently used at the back of lru.
+	entries      map[string]*list.Element
+	lru          *list.List
+	hits, misses int64
+}
+
+// NewClient caches up to size parts read through next for ttl.
+func NewClient(next def.InventoryClient, ttl time.Duration, size int) *client {
+	return &client{
+		next:    next,
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Invalidate drops the parts from the cache, so that they are read from
+// inventory next time. Inventory change notifications are applied through it.
+func (c *client) Invalidate(uuids ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, uuid := range uuids {
+		if element, ok := c.entries[uuid]; ok {
+			c.lru.Remove(element)
+			delete(c.entries, uuid)
+		}
+	}
+}
+
+func (c *client) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Stats{
+		Hits:   c.hits,
+		Misses: c.misses,
+		Size:   c.lru.Len(),
+	}
+}
+
+// get returns the unexpired entry of the part. It must be called with mu held.
+func (c *client) get(uuid string, now time.Time) (*entry, bool) {
+	element, ok := c.entries[uuid]
+	if !ok {
+		return nil, false
+	}
+	e := element.Value.(*entry)
+	if now.After(e.expiresAt) {
+		c.lru.Remove(element)
+		delete(c.entries, uuid)
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return e, true
+}
+
+// store caches the parts read for uuids, the uuids without a part as not in
+// inventory, and evicts the least recently used entries beyond the size.
+func (c *client) store(uuids []string, parts []*model.Part) {
+	byUuid := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		byUuid[part.Uuid] = part
+	}
+	expiresAt := time.Now().Add(c.ttl)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, uuid := range uuids {
+		e := &entry{uuid: uuid, part: byUuid[uuid], expiresAt: expiresAt}
+		if element, ok := c.entries[uuid]; ok {
+			element.Value = e
+			c.lru.MoveToFront(element)
+			continue
+		}
+		c.entries[uuid] = c.lru.PushFront(e)
+	}
+
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).uuid)
+	}
+}
@@ -0,0 +1,12 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// GetShippingInfo is not cached, it depends on the quantities ordered.
+func (c *client) GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error) {
+	return c.next.GetShippingInfo(ctx, items)
+}
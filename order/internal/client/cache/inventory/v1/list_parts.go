@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// lookupTimeout bounds an inventory request shared by several lookups, which
+// is not cancelled with the request that started it.
+const lookupTimeout = 5 * time.Second
+
+// ListParts returns cached parts and reads the rest from inventory in one
+// request. With filter.SkipCache all parts are read from inventory and cached.
+func (c *client) ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+	if filter.SkipCache {
+		parts, err := c.next.ListParts(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		c.store(filter.Uuids, parts)
+		return parts, nil
+	}
+
+	now := time.Now()
+	found := make(map[string]*model.Part, len(filter.Uuids))
+	var missing []string
+
+	c.mu.Lock()
+	for _, uuid := range filter.Uuids {
+		if _, ok := found[uuid]; ok || slices.Contains(missing, uuid) {
+			continue
+		}
+		e, ok := c.get(uuid, now)
+		if !ok {
+			missing = append(missing, uuid)
+			continue
+		}
+		found[uuid] = e.part
+	}
+	c.hits += int64(len(found))
+	c.misses += int64(len(missing))
+	c.mu.Unlock()
+
+	if len(missing) > 0 {
+		parts, err := c.lookup(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range parts {
+			found[part.Uuid] = part
+		}
+	}
+
+	parts := make([]*model.Part, 0, len(found))
+	for _, uuid := range filter.Uuids {
+		part := found[uuid]
+		if part == nil {
+			continue
+		}
+		// Cached parts are shared, callers get copies.
+		copied := *part
+		parts = append(parts, &copied)
+		delete(found, uuid)
+	}
+	return parts, nil
+}
+
+// lookup reads the parts from inventory and caches them. Concurrent lookups of
+// the same parts wait for one request.
+func (c *client) lookup(ctx context.Context, uuids []string) ([]*model.Part, error) {
+	key := strings.Join(slices.Sorted(slices.Values(uuids)), ",")
+
+	results := c.lookups.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lookupTimeout)
+		defer cancel()
+
+		parts, err := c.next.ListParts(ctx, model.PartsFilter{Uuids: uuids})
+		if err != nil {
+			return nil, err
+		}
+		c.store(uuids, parts)
+		return parts, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.([]*model.Part), nil
+	}
+}
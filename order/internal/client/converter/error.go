@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/grpc/resilience"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// ToModelError maps a failed upstream call to ErrUpstreamUnavailable if the
+// upstream is down or too slow, or to ErrUpstreamRejected if it rejected the
+// request as invalid. Other errors are returned as is.
+func ToModelError(err error) error {
+	switch {
+	case resilience.IsUnavailable(err):
+		return fmt.Errorf("%w: %v", model.ErrUpstreamUnavailable, err)
+	case status.Code(err) == codes.InvalidArgument, status.Code(err) == codes.OutOfRange:
+		return fmt.Errorf("%w: %v", model.ErrUpstreamRejected, err)
+	default:
+		return err
+	}
+}
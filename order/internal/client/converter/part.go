@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoPartsFilter(filter model.PartsFilter) *inventoryv1.PartsFilter {
+	return &inventoryv1.PartsFilter{
+		Uuids: filter.Uuids,
+	}
+}
+
+func ToModelPart(part *inventoryv1.Part) *model.Part {
+	return &model.Part{
+		Uuid:          part.GetUuid(),
+		Name:          part.GetName(),
+		PriceMinor:    part.GetPriceMinor(),
+		StockQuantity: part.GetStockQuantity(),
+		Category:      ToModelPartCategory(part.GetCategory()),
+		Manufacturer:  part.GetManufacturer().GetName(),
+	}
+}
+
+func ToModelPartCategory(category inventoryv1.Category) model.PartCategory {
+	switch category {
+	case inventoryv1.Category_CATEGORY_ENGINE:
+		return model.PartCategoryEngine
+	case inventoryv1.Category_CATEGORY_FUEL:
+		return model.PartCategoryFuel
+	case inventoryv1.Category_CATEGORY_PORTHOLE:
+		return model.PartCategoryPorthole
+	case inventoryv1.Category_CATEGORY_WING:
+		return model.PartCategoryWing
+	default:
+		return model.PartCategoryUnspecified
+	}
+}
+
+func ToModelParts(parts []*inventoryv1.Part) []*model.Part {
+	modelParts := make([]*model.Part, 0, len(parts))
+	for _, part := range parts {
+		modelParts = append(modelParts, ToModelPart(part))
+	}
+	return modelParts
+}
+
+func ToProtoStockAdjustments(adjustments []model.StockAdjustment) []*inventoryv1.StockAdjustment {
+	protoAdjustments := make([]*inventoryv1.StockAdjustment, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		protoAdjustments = append(protoAdjustments, &inventoryv1.StockAdjustment{
+			PartUuid: adjustment.PartUuid,
+			Delta:    adjustment.Delta,
+		})
+	}
+	return protoAdjustments
+}
+
+func ToProtoShippingItems(items []model.OrderItemRequest) []*inventoryv1.ShippingItem {
+	protoItems := make([]*inventoryv1.ShippingItem, 0, len(items))
+	for _, item := range items {
+		protoItems = append(protoItems, &inventoryv1.ShippingItem{
+			PartUuid: item.PartUuid,
+			Quantity: item.Quantity,
+		})
+	}
+	return protoItems
+}
+
+func ToModelShippingInfo(info *inventoryv1.ShippingInfo) *model.ShippingInfo {
+	return &model.ShippingInfo{
+		TotalWeight:      info.GetTotalWeight(),
+		TotalVolume:      info.GetTotalVolume(),
+		ChargeableWeight: info.GetChargeableWeight(),
+		MaxLength:        info.GetMaxLength(),
+	}
+}
@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+func ToProtoPaymentMethod(method model.PaymentMethod) paymentv1.PaymentMethod {
+	switch method {
+	case model.PaymentMethodUnspecified:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	case model.PaymentMethodCard:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSbp:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCreditCard:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodInvestorMoney:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
@@ -0,0 +1,25 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+type InventoryClient interface {
+	ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error)
+	// AdjustStock applies all adjustments atomically. It returns ErrInsufficientStock
+	// if any stock would become negative.
+	AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) error
+	// GetShippingInfo returns the aggregated size of the items in kilograms and centimeters.
+	GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error)
+}
+
+type PaymentClient interface {
+	// PayOrder pays the order, or its tender if tenderUuid is not empty, and
+	// returns the transaction UUID.
+	PayOrder(ctx context.Context, orderUuid, tenderUuid, userUuid string, paymentMethod model.PaymentMethod, amountMinor int64) (string, error)
+	// RefundPayment refunds amountMinor of the payment once per refundUuid and
+	// returns the refund transaction UUID.
+	RefundPayment(ctx context.Context, transactionUuid, refundUuid string, amountMinor int64, reason string) (string, error)
+}
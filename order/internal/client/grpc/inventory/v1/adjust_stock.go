@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) error {
+	_, err := c.generatedClient.AdjustStock(ctx, &inventoryv1.AdjustStockRequest{
+		Adjustments: converter.ToProtoStockAdjustments(adjustments),
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return model.ErrInsufficientStock
+		}
+		return converter.ToModelError(err)
+	}
+
+	return nil
+}
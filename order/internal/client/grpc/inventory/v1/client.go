@@ -0,0 +1,18 @@
+package v1
+
+import (
+	def "github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+var _ def.InventoryClient = &client{}
+
+type client struct {
+	generatedClient inventoryv1.InventoryServiceClient
+}
+
+func NewClient(generatedClient inventoryv1.InventoryServiceClient) *client {
+	return &client{
+		generatedClient: generatedClient,
+	}
+}
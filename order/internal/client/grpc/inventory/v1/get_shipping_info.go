@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error) {
+	res, err := c.generatedClient.GetShippingInfo(ctx, &inventoryv1.GetShippingInfoRequest{
+		Items:      converter.ToProtoShippingItems(items),
+		UnitSystem: inventoryv1.UnitSystem_UNIT_SYSTEM_METRIC,
+	})
+	if err != nil {
+		return nil, converter.ToModelError(err)
+	}
+
+	return converter.ToModelShippingInfo(res.GetShippingInfo()), nil
+}
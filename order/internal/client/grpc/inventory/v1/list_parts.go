@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	inventoryv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+	res, err := c.generatedClient.ListParts(ctx, &inventoryv1.ListPartsRequest{
+		Filter: converter.ToProtoPartsFilter(filter),
+	})
+	if err != nil {
+		return nil, converter.ToModelError(err)
+	}
+
+	return converter.ToModelParts(res.GetParts()), nil
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	def "github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+var _ def.PaymentClient = &client{}
+
+type client struct {
+	generatedClient paymentv1.PaymentServiceClient
+}
+
+func NewClient(generatedClient paymentv1.PaymentServiceClient) *client {
+	return &client{
+		generatedClient: generatedClient,
+	}
+}
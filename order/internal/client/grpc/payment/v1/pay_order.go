@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) PayOrder(ctx context.Context, orderUuid, tenderUuid, userUuid string, paymentMethod model.PaymentMethod, amountMinor int64) (string, error) {
+	res, err := c.generatedClient.PayOrder(ctx, &paymentv1.PayOrderRequest{
+		OrderUuid:     orderUuid,
+		UserUuid:      userUuid,
+		PaymentMethod: converter.ToProtoPaymentMethod(paymentMethod),
+		AmountMinor:   amountMinor,
+		TenderUuid:    tenderUuid,
+	})
+	if err != nil {
+		// The payment service pays an order or tender once and rejects a
+		// repeated payment with different parameters.
+		if status.Code(err) == codes.AlreadyExists {
+			return "", model.ErrOrderAlreadyPaid
+		}
+		return "", converter.ToModelError(err)
+	}
+
+	return res.GetTransactionUuid(), nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/converter"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) RefundPayment(ctx context.Context, transactionUuid, refundUuid string, amountMinor int64, reason string) (string, error) {
+	res, err := c.generatedClient.RefundPayment(ctx, &paymentv1.RefundPaymentRequest{
+		TransactionUuid: transactionUuid,
+		RefundUuid:      refundUuid,
+		AmountMinor:     amountMinor,
+		Reason:          reason,
+	})
+	if err != nil {
+		return "", converter.ToModelError(err)
+	}
+
+	return res.GetRefundTransactionUuid(), nil
+}
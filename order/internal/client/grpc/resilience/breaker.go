@@ -0,0 +1,113 @@
+package resilience
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// breaker is the circuit breaker of an upstream.
+type breaker struct {
+	upstream string
+	policy   BreakerPolicy
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	// A half-open breaker lets through one probe at a time.
+	probing bool
+}
+
+func newBreaker(upstream string, policy BreakerPolicy) *breaker {
+	return &breaker{
+		upstream: upstream,
+		policy:   policy,
+	}
+}
+
+// allow reports whether an attempt may be made. An allowed attempt must be
+// followed by record.
+func (b *breaker) allow() bool {
+	if b.policy.FailureThreshold == 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.policy.OpenTimeout {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// record counts the outcome of an allowed attempt of a call made with ctx.
+// Attempts cancelled by the caller, or cut off by the deadline of the caller
+// rather than the budget of the call, tell nothing about the upstream and are
+// not counted.
+func (b *breaker) record(ctx context.Context, err error) {
+	if b.policy.FailureThreshold == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	switch {
+	case status.Code(err) == codes.Canceled, ctx.Err() != nil:
+	case !failed(err):
+		if b.state != breakerClosed {
+			log.Printf("%s circuit breaker closed", b.upstream)
+		}
+		b.state = breakerClosed
+		b.failures = 0
+	case b.state == breakerHalfOpen:
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	default:
+		b.failures++
+		if b.state == breakerClosed && b.failures >= b.policy.FailureThreshold {
+			log.Printf("%s circuit breaker opened after %d failures", b.upstream, b.failures)
+			b.state = breakerOpen
+			b.openedAt = time.Now()
+		}
+	}
+}
+
+// failed reports whether the attempt failed because of the upstream: it is
+// unavailable or answered with an internal error.
+func failed(err error) bool {
+	switch status.Code(err) {
+	case codes.Internal, codes.Unknown:
+		return true
+	default:
+		return IsUnavailable(err)
+	}
+}
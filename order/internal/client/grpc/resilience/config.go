@@ -0,0 +1,49 @@
+// Package resilience guards calls of upstream gRPC services with deadlines,
+// retries and a circuit breaker, configured per method.
+package resilience
+
+import "time"
+
+// Config is the resilience of the calls of one upstream service.
+type Config struct {
+	// Policy of the methods not in Methods.
+	Default Policy
+	// Policies by full method name, such as "/inventory.v1.InventoryService/ListParts".
+	Methods map[string]Policy
+	Breaker BreakerPolicy
+}
+
+// Policy is how calls of a method are made.
+type Policy struct {
+	// Deadline budget of the call including retries, none if zero. A shorter
+	// deadline of the caller is kept.
+	Timeout time.Duration
+	// Deadline of one attempt, so that a hung attempt leaves time to retry.
+	// Attempts are only bound by Timeout if zero.
+	AttemptTimeout time.Duration
+	// Idempotent methods are retried on transient failures, up to MaxAttempts
+	// attempts in all.
+	Idempotent  bool
+	MaxAttempts int
+	// Retries wait a random time up to BaseBackoff doubled with every retry,
+	// but at most MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// BreakerPolicy is when the circuit breaker of an upstream opens. An open
+// breaker fails calls without making them, and after OpenTimeout lets one call
+// through to probe whether the upstream is back.
+type BreakerPolicy struct {
+	// Consecutive failed attempts that open the breaker, the breaker is
+	// disabled if zero.
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+func (c Config) policy(method string) Policy {
+	if policy, ok := c.Methods[method]; ok {
+		return policy
+	}
+	return c.Default
+}
@@ -0,0 +1,114 @@
+package resilience
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryClientInterceptor makes the calls of upstream within the deadline
+// budget of their method, retries idempotent methods on transient failures
+// with jittered exponential backoff, and fails calls with Unavailable while the
+// circuit breaker of upstream is open.
+func UnaryClientInterceptor(upstream string, config Config) grpc.UnaryClientInterceptor {
+	breaker := newBreaker(upstream, config.Breaker)
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		policy := config.policy(method)
+		caller := ctx
+		if policy.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+			defer cancel()
+		}
+
+		attempts := 1
+		if policy.Idempotent && policy.MaxAttempts > 1 {
+			attempts = policy.MaxAttempts
+		}
+
+		var err error
+		for attempt := range attempts {
+			if attempt > 0 && !wait(ctx, backoff(policy, attempt)) {
+				return err
+			}
+			if !breaker.allow() {
+				return status.Errorf(codes.Unavailable, "%s circuit breaker is open", upstream)
+			}
+
+			err = invoke(ctx, policy, method, req, reply, cc, invoker, opts...)
+			breaker.record(caller, err)
+			if !retryable(ctx, err) {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func invoke(ctx context.Context, policy Policy, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if policy.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.AttemptTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// IsUnavailable reports whether err means that the upstream is down,
+// overloaded or too slow, as opposed to an answer of the upstream.
+func IsUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryable reports whether a failed attempt is worth retrying: the upstream
+// was unreachable or overloaded, or the attempt timed out with time left.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns a random delay up to the exponential backoff of the retry.
+func backoff(policy Policy, retry int) time.Duration {
+	ceiling := policy.BaseBackoff << (retry - 1)
+	if ceiling <= 0 || ceiling > policy.MaxBackoff {
+		ceiling = policy.MaxBackoff
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling)
+}
+
+// wait sleeps for delay and reports whether ctx allows another attempt after it.
+func wait(ctx context.Context, delay time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
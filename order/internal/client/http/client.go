@@ -0,0 +1,13 @@
+package http
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+type WebhookClient interface {
+	// Send POSTs the signed event to the webhook endpoint. It returns an error
+	// unless the endpoint responds with a 2xx status.
+	Send(ctx context.Context, webhook *model.Webhook, event *model.WebhookEvent) error
+}
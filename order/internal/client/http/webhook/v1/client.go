@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"net/http"
+
+	def "github.com/qyrlabs/test-backend/order/internal/client/http"
+)
+
+var _ def.WebhookClient = &client{}
+
+type client struct {
+	httpClient *http.Client
+}
+
+func NewClient(httpClient *http.Client) *client {
+	return &client{
+		httpClient: httpClient,
+	}
+}
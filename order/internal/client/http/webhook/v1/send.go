@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/converter"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Headers of webhook requests, see the Webhooks tag of the order API.
+const (
+	headerID        = "X-Webhook-Id"
+	headerEvent     = "X-Webhook-Event"
+	headerTimestamp = "X-Webhook-Timestamp"
+	headerSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBody limits how much of the response is read to reuse the connection.
+const maxResponseBody = 64 << 10
+
+func (c *client) Send(ctx context.Context, webhook *model.Webhook, event *model.WebhookEvent) error {
+	apiEvent := converter.ToAPIWebhookEvent(event)
+	body, err := apiEvent.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerID, event.Uuid)
+	req.Header.Set(headerEvent, string(apiEvent.Type))
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerSignature, "sha256="+Sign(webhook.Secret, timestamp, body))
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseBody))
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook endpoint responded with %s", res.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of timestamp, a dot and body keyed by secret.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,118 @@
+package converter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+// defaultListLimit matches the default of the limit parameter in the API spec.
+const defaultListLimit = 20
+
+// cursor is the wire form of model.OrdersCursor. Clients treat it as opaque.
+type cursor struct {
+	SortBy          string    `json:"s"`
+	Descending      bool      `json:"d"`
+	CreatedAt       time.Time `json:"c"`
+	TotalPriceMinor int64     `json:"p"`
+	OrderUuid       string    `json:"u"`
+}
+
+func ToModelOrdersQuery(params orderv1.ListOrdersParams) (model.OrdersQuery, error) {
+	query := model.OrdersQuery{
+		Filter: model.OrdersFilter{
+			Statuses: ToModelOrderStatuses(params.Status),
+		},
+		SortBy:     ToModelOrdersSortBy(params.SortBy.Or(orderv1.OrderSortByCreatedAt)),
+		Descending: params.SortOrder.Or(orderv1.SortOrderDesc) == orderv1.SortOrderDesc,
+		Limit:      params.Limit.Or(defaultListLimit),
+	}
+	if userUuid, ok := params.UserUUID.Get(); ok {
+		query.Filter.UserUuid = userUuid.String()
+	}
+	if partUuid, ok := params.PartUUID.Get(); ok {
+		query.Filter.PartUuid = partUuid.String()
+	}
+	if createdFrom, ok := params.CreatedFrom.Get(); ok {
+		query.Filter.CreatedFrom = &createdFrom
+	}
+	if createdTo, ok := params.CreatedTo.Get(); ok {
+		query.Filter.CreatedTo = &createdTo
+	}
+	if encoded, ok := params.Cursor.Get(); ok {
+		decoded, err := DecodeOrdersCursor(encoded)
+		if err != nil {
+			return model.OrdersQuery{}, err
+		}
+		query.Cursor = decoded
+	}
+	return query, nil
+}
+
+func ToModelOrdersSortBy(sortBy orderv1.OrderSortBy) model.OrdersSortBy {
+	switch sortBy {
+	case orderv1.OrderSortByTotalPriceMinor:
+		return model.OrdersSortByTotalPriceMinor
+	default:
+		return model.OrdersSortByCreatedAt
+	}
+}
+
+func ToAPIOrdersSortBy(sortBy model.OrdersSortBy) orderv1.OrderSortBy {
+	switch sortBy {
+	case model.OrdersSortByTotalPriceMinor:
+		return orderv1.OrderSortByTotalPriceMinor
+	default:
+		return orderv1.OrderSortByCreatedAt
+	}
+}
+
+func ToAPIOrderListResponse(page *model.OrdersPage) *orderv1.OrderListResponse {
+	res := &orderv1.OrderListResponse{
+		Orders: ToAPIOrders(page.Orders),
+	}
+	if page.NextCursor != nil {
+		res.NextCursor = orderv1.NewOptString(EncodeOrdersCursor(*page.NextCursor))
+	}
+	return res
+}
+
+func EncodeOrdersCursor(c model.OrdersCursor) string {
+	// Marshalling a struct of plain fields cannot fail.
+	data, _ := json.Marshal(cursor{
+		SortBy:          string(ToAPIOrdersSortBy(c.SortBy)),
+		Descending:      c.Descending,
+		CreatedAt:       c.CreatedAt,
+		TotalPriceMinor: c.TotalPriceMinor,
+		OrderUuid:       c.OrderUuid,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeOrdersCursor(encoded string) (*model.OrdersCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+
+	sortBy := orderv1.OrderSortBy(c.SortBy)
+	if err := sortBy.Validate(); err != nil || c.OrderUuid == "" {
+		return nil, model.ErrInvalidCursor
+	}
+
+	return &model.OrdersCursor{
+		SortBy:          ToModelOrdersSortBy(sortBy),
+		Descending:      c.Descending,
+		CreatedAt:       c.CreatedAt,
+		TotalPriceMinor: c.TotalPriceMinor,
+		OrderUuid:       c.OrderUuid,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func TestOrdersCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 1, 1, 12, 30, 0, 123456789, time.UTC)
+
+	for _, cursor := range []model.OrdersCursor{
+		{SortBy: model.OrdersSortByCreatedAt, Descending: true, CreatedAt: createdAt, TotalPriceMinor: 1500, OrderUuid: uuid.NewString()},
+		{SortBy: model.OrdersSortByTotalPriceMinor, CreatedAt: createdAt, OrderUuid: uuid.NewString()},
+	} {
+		decoded, err := DecodeOrdersCursor(EncodeOrdersCursor(cursor))
+		if err != nil {
+			t.Fatalf("decode cursor: %v", err)
+		}
+		if !decoded.CreatedAt.Equal(cursor.CreatedAt) {
+			t.Errorf("cursor created at %v, want %v", decoded.CreatedAt, cursor.CreatedAt)
+		}
+		decoded.CreatedAt = cursor.CreatedAt
+		if *decoded != cursor {
+			t.Errorf("decoded cursor %+v, want %+v", *decoded, cursor)
+		}
+	}
+}
+
+func TestDecodeOrdersCursorRejectsInvalid(t *testing.T) {
+	encode := func(data string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(data))
+	}
+
+	for name, encoded := range map[string]string{
+		"not base64":      "!!!",
+		"not json":        encode("cursor"),
+		"unknown sort":    encode(`{"s":"name","u":"7d3c1f0e-0000-4000-8000-000000000000"}`),
+		"missing uuid":    encode(`{"s":"created_at"}`),
+		"padded encoding": base64.URLEncoding.EncodeToString([]byte(`{"s":"created_at","u":"x"}`)),
+	} {
+		if _, err := DecodeOrdersCursor(encoded); !errors.Is(err, model.ErrInvalidCursor) {
+			t.Errorf("%s: got %v, want %v", name, err, model.ErrInvalidCursor)
+		}
+	}
+}
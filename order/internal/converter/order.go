@@ -0,0 +1,419 @@
+package converter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+func ToAPIOrder(order *model.Order) *orderv1.Order {
+	apiOrder := &orderv1.Order{
+		OrderUUID:          uuid.MustParse(order.OrderUuid),
+		Version:            order.Version,
+		UserUUID:           uuid.MustParse(order.UserUuid),
+		Items:              ToAPIOrderItems(order.Items),
+		SubtotalMinor:      order.SubtotalMinor,
+		DiscountMinor:      order.DiscountMinor,
+		ShippingMinor:      order.Shipment.CostMinor,
+		TaxMinor:           order.TaxMinor,
+		Taxes:              ToAPITaxLines(order.Taxes),
+		Shipment:           ToAPIShipment(order.Shipment),
+		TotalPriceMinor:    order.TotalPriceMinor,
+		Status:             ToAPIOrderStatus(order.Status),
+		CreatedAt:          order.CreatedAt,
+		PaymentDeadline:    order.PaymentDeadline,
+		PaidMinor:          order.PaidMinor,
+		Payments:           ToAPIOrderPayments(order.Payments),
+		RefundedTotalMinor: order.RefundedMinor,
+		Refunds:            ToAPIOrderRefunds(order.Refunds),
+	}
+	if order.TransactionUuid != "" {
+		apiOrder.TransactionUUID = orderv1.NewOptUUID(uuid.MustParse(order.TransactionUuid))
+	}
+	if order.PromoCode != "" {
+		apiOrder.PromoCode = orderv1.NewOptString(order.PromoCode)
+	}
+	if order.PaymentMethod != model.PaymentMethodUnspecified {
+		apiOrder.PaymentMethod = orderv1.NewOptPaymentMethod(ToAPIPaymentMethod(order.PaymentMethod))
+	}
+	if order.PaidAt != nil {
+		apiOrder.PaidAt = orderv1.NewOptDateTime(*order.PaidAt)
+	}
+	if order.CancelledAt != nil {
+		apiOrder.CancelledAt = orderv1.NewOptDateTime(*order.CancelledAt)
+	}
+	return apiOrder
+}
+
+// ToAPIOrderHeaders returns the order with its version as the ETag header.
+func ToAPIOrderHeaders(order *model.Order) *orderv1.OrderHeaders {
+	return &orderv1.OrderHeaders{
+		Etag:     ToAPIETag(order.Version),
+		Response: *ToAPIOrder(order),
+	}
+}
+
+// ToAPIETag formats the order version as a strong entity tag.
+func ToAPIETag(version int64) orderv1.OptString {
+	return orderv1.NewOptString(`"` + strconv.FormatInt(version, 10) + `"`)
+}
+
+// ToModelIfMatch returns the order versions accepted by the If-Match header,
+// nil if the header is absent or "*". Weak and malformed entity tags never
+// match, so a header without valid tags yields an empty slice.
+func ToModelIfMatch(header orderv1.OptString) []int64 {
+	value, ok := header.Get()
+	if !ok || strings.TrimSpace(value) == "*" {
+		return nil
+	}
+
+	versions := []int64{}
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	return versions
+}
+
+// ExpandAPIOrderParts embeds the parts of the expansion in the items of the
+// order and marks the expansion partial if inventory could not be read.
+func ExpandAPIOrderParts(order *orderv1.Order, expansion *model.PartsExpansion) {
+	if expansion.Partial {
+		order.ExpandPartial = []orderv1.OrderExpand{orderv1.OrderExpandParts}
+		return
+	}
+	for i := range order.Items {
+		if part, ok := expansion.Parts[order.Items[i].PartUUID.String()]; ok {
+			order.Items[i].Part = orderv1.NewOptPartSummary(ToAPIPartSummary(part))
+		}
+	}
+}
+
+func ToAPIPartSummary(part *model.Part) orderv1.PartSummary {
+	summary := orderv1.PartSummary{
+		UUID:       uuid.MustParse(part.Uuid),
+		Name:       part.Name,
+		PriceMinor: part.PriceMinor,
+	}
+	if part.Category != model.PartCategoryUnspecified {
+		summary.Category = orderv1.NewOptPartCategory(ToAPIPartCategory(part.Category))
+	}
+	if part.Manufacturer != "" {
+		summary.Manufacturer = orderv1.NewOptString(part.Manufacturer)
+	}
+	return summary
+}
+
+func ToAPIOrderItems(items []model.OrderItem) []orderv1.OrderItem {
+	apiItems := make([]orderv1.OrderItem, 0, len(items))
+	for _, item := range items {
+		apiItems = append(apiItems, orderv1.OrderItem{
+			PartUUID:         uuid.MustParse(item.PartUuid),
+			PartName:         item.PartName,
+			Quantity:         item.Quantity,
+			UnitPriceMinor:   item.UnitPriceMinor,
+			LineTotalMinor:   item.LineTotalMinor,
+			DiscountMinor:    item.DiscountMinor,
+			TaxMinor:         item.TaxMinor,
+			RefundedQuantity: item.RefundedQuantity,
+		})
+	}
+	return apiItems
+}
+
+func ToAPIShipment(shipment model.Shipment) orderv1.Shipment {
+	return orderv1.Shipment{
+		Country:            shipment.Country,
+		ChargeableWeightKg: shipment.ChargeableWeightKg,
+		VolumeCm3:          shipment.VolumeCm3,
+	}
+}
+
+func ToAPITaxLines(taxes []model.TaxLine) []orderv1.TaxLine {
+	apiTaxes := make([]orderv1.TaxLine, 0, len(taxes))
+	for _, tax := range taxes {
+		apiTax := orderv1.TaxLine{
+			Shipping:        tax.Shipping,
+			RateBasisPoints: tax.RateBasisPoints,
+			TaxableMinor:    tax.TaxableMinor,
+			TaxMinor:        tax.TaxMinor,
+		}
+		if !tax.Shipping {
+			apiTax.Category = orderv1.NewOptPartCategory(ToAPIPartCategory(tax.Category))
+		}
+		apiTaxes = append(apiTaxes, apiTax)
+	}
+	return apiTaxes
+}
+
+func ToModelOrderRequest(req *orderv1.OrderCreateRequest) model.OrderRequest {
+	return model.OrderRequest{
+		UserUuid:        uuid.UUID(req.GetUserUUID()).String(),
+		Items:           ToModelOrderItemRequests(req.GetItems()),
+		PromoCode:       string(req.GetPromoCode().Or("")),
+		ShippingCountry: req.GetShippingCountry().Or(""),
+	}
+}
+
+func ToModelOrderUpdateRequest(req *orderv1.OrderUpdateRequest) model.OrderUpdateRequest {
+	items := make([]model.OrderItemRequest, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, model.OrderItemRequest{
+			PartUuid: uuid.UUID(item.GetPartUUID()).String(),
+			Quantity: item.GetQuantity(),
+		})
+	}
+	return model.OrderUpdateRequest{
+		Items: items,
+	}
+}
+
+func ToModelOrderItemRequests(items []orderv1.OrderItemRequest) []model.OrderItemRequest {
+	modelItems := make([]model.OrderItemRequest, 0, len(items))
+	for _, item := range items {
+		modelItems = append(modelItems, model.OrderItemRequest{
+			PartUuid: uuid.UUID(item.GetPartUUID()).String(),
+			Quantity: int64(item.GetQuantity()),
+		})
+	}
+	return modelItems
+}
+
+func ToAPIOrderPayments(payments []model.Payment) []orderv1.OrderPayment {
+	apiPayments := make([]orderv1.OrderPayment, 0, len(payments))
+	for _, payment := range payments {
+		apiPayments = append(apiPayments, orderv1.OrderPayment{
+			TransactionUUID: uuid.MustParse(payment.TransactionUuid),
+			PaymentMethod:   ToAPIPaymentMethod(payment.PaymentMethod),
+			AmountMinor:     payment.AmountMinor,
+			RefundedMinor:   payment.RefundedMinor,
+			PaidAt:          payment.PaidAt,
+		})
+	}
+	return apiPayments
+}
+
+func ToAPIOrderPayResponse(order *model.Order) *orderv1.OrderPayResponseHeaders {
+	transactionUuids := make([]uuid.UUID, 0, len(order.Payments))
+	for _, payment := range order.Payments {
+		transactionUuids = append(transactionUuids, uuid.MustParse(payment.TransactionUuid))
+	}
+	return &orderv1.OrderPayResponseHeaders{
+		Etag: ToAPIETag(order.Version),
+		Response: orderv1.OrderPayResponse{
+			TransactionUUID:  uuid.MustParse(order.TransactionUuid),
+			TransactionUuids: transactionUuids,
+		},
+	}
+}
+
+func ToModelPayRequest(req *orderv1.OrderPayRequest) model.PayRequest {
+	tenders := make([]model.Tender, 0, len(req.GetTenders()))
+	for _, tender := range req.GetTenders() {
+		tenders = append(tenders, model.Tender{
+			PaymentMethod: ToModelPaymentMethod(tender.GetPaymentMethod()),
+			AmountMinor:   tender.GetAmountMinor(),
+		})
+	}
+	payRequest := model.PayRequest{Tenders: tenders}
+	if method, ok := req.GetPaymentMethod().Get(); ok {
+		payRequest.PaymentMethod = ToModelPaymentMethod(method)
+	}
+	return payRequest
+}
+
+// ToAPIPayConflictError returns the conflict of a payment of an order whose
+// parts changed in inventory, with the repriced order if there is one.
+func ToAPIPayConflictError(code int, err *model.ItemsChangedError) *orderv1.PayOrderConflict {
+	apiErr := orderv1.PayConflictError{
+		Code:    code,
+		Message: err.Error(),
+		Changes: ToAPIItemChanges(err.Changes),
+	}
+	if err.Repriced != nil {
+		apiErr.Order = orderv1.NewOptOrder(*ToAPIOrder(err.Repriced))
+	}
+	conflict := orderv1.NewPayConflictErrorPayOrderConflict(apiErr)
+	return &conflict
+}
+
+func ToAPIItemChanges(changes []model.ItemChange) []orderv1.ItemChange {
+	apiChanges := make([]orderv1.ItemChange, 0, len(changes))
+	for _, change := range changes {
+		apiChange := orderv1.ItemChange{
+			PartUUID:          uuid.MustParse(change.PartUuid),
+			PartName:          change.PartName,
+			Change:            ToAPIItemChangeKind(change.Kind),
+			Quantity:          change.Quantity,
+			OrderedPriceMinor: change.OrderedPriceMinor,
+		}
+		if change.Kind != model.ItemChangeKindDiscontinued {
+			apiChange.CurrentPriceMinor = orderv1.NewOptInt64(change.CurrentPriceMinor)
+			apiChange.StockQuantity = orderv1.NewOptInt64(change.StockQuantity)
+		}
+		apiChanges = append(apiChanges, apiChange)
+	}
+	return apiChanges
+}
+
+func ToAPIItemChangeKind(kind model.ItemChangeKind) orderv1.ItemChangeKind {
+	switch kind {
+	case model.ItemChangeKindDiscontinued:
+		return orderv1.ItemChangeKindITEMCHANGEDISCONTINUED
+	case model.ItemChangeKindOutOfStock:
+		return orderv1.ItemChangeKindITEMCHANGEOUTOFSTOCK
+	default:
+		return orderv1.ItemChangeKindITEMCHANGEPRICECHANGED
+	}
+}
+
+func ToAPIOrderRefunds(refunds []model.Refund) []orderv1.OrderRefund {
+	apiRefunds := make([]orderv1.OrderRefund, 0, len(refunds))
+	for _, refund := range refunds {
+		apiItems := make([]orderv1.OrderItemRequest, 0, len(refund.Items))
+		for _, item := range refund.Items {
+			apiItems = append(apiItems, orderv1.OrderItemRequest{
+				PartUUID: orderv1.PartUUID(uuid.MustParse(item.PartUuid)),
+				Quantity: orderv1.Quantity(item.Quantity),
+			})
+		}
+		apiRefunds = append(apiRefunds, orderv1.OrderRefund{
+			RefundTransactionUUID: uuid.MustParse(refund.TransactionUuid),
+			AmountMinor:           refund.AmountMinor,
+			Items:                 apiItems,
+			Reason:                refund.Reason,
+			CreatedAt:             refund.CreatedAt,
+		})
+	}
+	return apiRefunds
+}
+
+func ToModelRefundRequest(req *orderv1.OrderRefundRequest) model.RefundRequest {
+	items := make([]model.RefundItem, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, model.RefundItem{
+			PartUuid: uuid.UUID(item.GetPartUUID()).String(),
+			Quantity: int64(item.GetQuantity()),
+		})
+	}
+	return model.RefundRequest{
+		Items:       items,
+		AmountMinor: req.GetAmountMinor().Or(0),
+		Reason:      req.GetReason().Or(""),
+	}
+}
+
+func ToAPIOrders(orders []*model.Order) []orderv1.Order {
+	apiOrders := make([]orderv1.Order, 0, len(orders))
+	for _, order := range orders {
+		apiOrders = append(apiOrders, *ToAPIOrder(order))
+	}
+	return apiOrders
+}
+
+func ToAPIStatusTransitions(transitions []model.StatusTransition) []orderv1.StatusTransition {
+	apiTransitions := make([]orderv1.StatusTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		apiTransition := orderv1.StatusTransition{
+			ToStatus: ToAPIOrderStatus(transition.To),
+			At:       transition.At,
+			Actor:    transition.Actor,
+			Reason:   transition.Reason,
+		}
+		if transition.From != model.OrderStatusUnspecified {
+			apiTransition.FromStatus = orderv1.NewOptOrderStatus(ToAPIOrderStatus(transition.From))
+		}
+		apiTransitions = append(apiTransitions, apiTransition)
+	}
+	return apiTransitions
+}
+
+func ToAPIOrderStatus(status model.OrderStatus) orderv1.OrderStatus {
+	switch status {
+	case model.OrderStatusPendingPayment:
+		return orderv1.OrderStatusSTATUSPENDINGPAYMENT
+	case model.OrderStatusPartiallyPaid:
+		return orderv1.OrderStatusSTATUSPARTIALLYPAID
+	case model.OrderStatusPaid:
+		return orderv1.OrderStatusSTATUSPAID
+	case model.OrderStatusCancelled:
+		return orderv1.OrderStatusSTATUSCANCELLED
+	case model.OrderStatusPartiallyRefunded:
+		return orderv1.OrderStatusSTATUSPARTIALLYREFUNDED
+	case model.OrderStatusRefunded:
+		return orderv1.OrderStatusSTATUSREFUNDED
+	default:
+		return orderv1.OrderStatusSTATUSPENDINGPAYMENT
+	}
+}
+
+func ToModelOrderStatus(status orderv1.OrderStatus) model.OrderStatus {
+	switch status {
+	case orderv1.OrderStatusSTATUSPENDINGPAYMENT:
+		return model.OrderStatusPendingPayment
+	case orderv1.OrderStatusSTATUSPARTIALLYPAID:
+		return model.OrderStatusPartiallyPaid
+	case orderv1.OrderStatusSTATUSPAID:
+		return model.OrderStatusPaid
+	case orderv1.OrderStatusSTATUSCANCELLED:
+		return model.OrderStatusCancelled
+	case orderv1.OrderStatusSTATUSPARTIALLYREFUNDED:
+		return model.OrderStatusPartiallyRefunded
+	case orderv1.OrderStatusSTATUSREFUNDED:
+		return model.OrderStatusRefunded
+	default:
+		return model.OrderStatusUnspecified
+	}
+}
+
+func ToModelOrderStatuses(statuses []orderv1.OrderStatus) []model.OrderStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+	modelStatuses := make([]model.OrderStatus, 0, len(statuses))
+	for _, status := range statuses {
+		modelStatuses = append(modelStatuses, ToModelOrderStatus(status))
+	}
+	return modelStatuses
+}
+
+func ToAPIPaymentMethod(method model.PaymentMethod) orderv1.PaymentMethod {
+	switch method {
+	case model.PaymentMethodCard:
+		return orderv1.PaymentMethodPAYMENTMETHODCARD
+	case model.PaymentMethodSbp:
+		return orderv1.PaymentMethodPAYMENTMETHODSBP
+	case model.PaymentMethodCreditCard:
+		return orderv1.PaymentMethodPAYMENTMETHODCREDITCARD
+	case model.PaymentMethodInvestorMoney:
+		return orderv1.PaymentMethodPAYMENTMETHODINVESTORMONEY
+	default:
+		return orderv1.PaymentMethodPAYMENTMETHODUNSPECIFIED
+	}
+}
+
+func ToModelPaymentMethod(method orderv1.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case orderv1.PaymentMethodPAYMENTMETHODCARD:
+		return model.PaymentMethodCard
+	case orderv1.PaymentMethodPAYMENTMETHODSBP:
+		return model.PaymentMethodSbp
+	case orderv1.PaymentMethodPAYMENTMETHODCREDITCARD:
+		return model.PaymentMethodCreditCard
+	case orderv1.PaymentMethodPAYMENTMETHODINVESTORMONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
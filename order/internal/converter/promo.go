@@ -0,0 +1,123 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+func ToModelPromoCode(req *orderv1.PromoCodeCreateRequest) *model.PromoCode {
+	categories := make([]model.PartCategory, 0, len(req.GetCategories()))
+	for _, category := range req.GetCategories() {
+		categories = append(categories, ToModelPartCategory(category))
+	}
+
+	promo := &model.PromoCode{
+		Code:               string(req.GetCode()),
+		Kind:               ToModelPromoCodeKind(req.GetKind()),
+		PercentOff:         int64(req.GetPercentOff().Or(0)),
+		AmountOffMinor:     int64(req.GetAmountOffMinor().Or(0)),
+		ValidFrom:          req.GetValidFrom().Or(time.Time{}),
+		MaxUses:            int64(req.GetMaxUses().Or(0)),
+		MaxUsesPerUser:     int64(req.GetMaxUsesPerUser().Or(0)),
+		MinOrderTotalMinor: int64(req.GetMinOrderTotalMinor().Or(0)),
+		Categories:         categories,
+	}
+	if validUntil, ok := req.GetValidUntil().Get(); ok {
+		t := time.Time(validUntil)
+		promo.ValidUntil = &t
+	}
+	return promo
+}
+
+func ToAPIPromoCode(promo *model.PromoCode) *orderv1.PromoCode {
+	categories := make([]orderv1.PartCategory, 0, len(promo.Categories))
+	for _, category := range promo.Categories {
+		categories = append(categories, ToAPIPartCategory(category))
+	}
+
+	apiPromo := &orderv1.PromoCode{
+		Code:       promo.Code,
+		Kind:       ToAPIPromoCodeKind(promo.Kind),
+		ValidFrom:  promo.ValidFrom,
+		Categories: categories,
+		UsedCount:  promo.UsedCount,
+		CreatedAt:  promo.CreatedAt,
+	}
+	if promo.PercentOff != 0 {
+		apiPromo.PercentOff = orderv1.NewOptInt64(promo.PercentOff)
+	}
+	if promo.AmountOffMinor != 0 {
+		apiPromo.AmountOffMinor = orderv1.NewOptInt64(promo.AmountOffMinor)
+	}
+	if promo.ValidUntil != nil {
+		apiPromo.ValidUntil = orderv1.NewOptDateTime(*promo.ValidUntil)
+	}
+	if promo.MaxUses != 0 {
+		apiPromo.MaxUses = orderv1.NewOptInt64(promo.MaxUses)
+	}
+	if promo.MaxUsesPerUser != 0 {
+		apiPromo.MaxUsesPerUser = orderv1.NewOptInt64(promo.MaxUsesPerUser)
+	}
+	if promo.MinOrderTotalMinor != 0 {
+		apiPromo.MinOrderTotalMinor = orderv1.NewOptInt64(promo.MinOrderTotalMinor)
+	}
+	return apiPromo
+}
+
+func ToAPIPromoCodes(promoCodes []*model.PromoCode) []orderv1.PromoCode {
+	apiPromoCodes := make([]orderv1.PromoCode, 0, len(promoCodes))
+	for _, promo := range promoCodes {
+		apiPromoCodes = append(apiPromoCodes, *ToAPIPromoCode(promo))
+	}
+	return apiPromoCodes
+}
+
+func ToModelPromoCodeKind(kind orderv1.PromoCodeKind) model.PromoCodeKind {
+	switch kind {
+	case orderv1.PromoCodeKindPROMOCODEKINDPERCENTAGE:
+		return model.PromoCodeKindPercentage
+	case orderv1.PromoCodeKindPROMOCODEKINDFIXEDAMOUNT:
+		return model.PromoCodeKindFixedAmount
+	default:
+		return model.PromoCodeKindUnspecified
+	}
+}
+
+func ToAPIPromoCodeKind(kind model.PromoCodeKind) orderv1.PromoCodeKind {
+	switch kind {
+	case model.PromoCodeKindFixedAmount:
+		return orderv1.PromoCodeKindPROMOCODEKINDFIXEDAMOUNT
+	default:
+		return orderv1.PromoCodeKindPROMOCODEKINDPERCENTAGE
+	}
+}
+
+func ToModelPartCategory(category orderv1.PartCategory) model.PartCategory {
+	switch category {
+	case orderv1.PartCategoryCATEGORYENGINE:
+		return model.PartCategoryEngine
+	case orderv1.PartCategoryCATEGORYFUEL:
+		return model.PartCategoryFuel
+	case orderv1.PartCategoryCATEGORYPORTHOLE:
+		return model.PartCategoryPorthole
+	case orderv1.PartCategoryCATEGORYWING:
+		return model.PartCategoryWing
+	default:
+		return model.PartCategoryUnspecified
+	}
+}
+
+func ToAPIPartCategory(category model.PartCategory) orderv1.PartCategory {
+	switch category {
+	case model.PartCategoryFuel:
+		return orderv1.PartCategoryCATEGORYFUEL
+	case model.PartCategoryPorthole:
+		return orderv1.PartCategoryCATEGORYPORTHOLE
+	case model.PartCategoryWing:
+		return orderv1.PartCategoryCATEGORYWING
+	default:
+		return orderv1.PartCategoryCATEGORYENGINE
+	}
+}
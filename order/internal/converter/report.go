@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+func ToModelSalesQuery(params orderv1.GetSalesReportParams) model.SalesQuery {
+	groupBy := make([]model.SalesDimension, 0, len(params.GroupBy))
+	for _, dimension := range params.GroupBy {
+		groupBy = append(groupBy, ToModelSalesDimension(dimension))
+	}
+	return model.SalesQuery{
+		From:    params.From,
+		To:      params.To,
+		GroupBy: groupBy,
+	}
+}
+
+func ToAPISalesReport(report *model.SalesReport) *orderv1.SalesReportResponse {
+	groupBy := make([]orderv1.SalesDimension, 0, len(report.Query.GroupBy))
+	for _, dimension := range report.Query.GroupBy {
+		groupBy = append(groupBy, ToAPISalesDimension(dimension))
+	}
+	return &orderv1.SalesReportResponse{
+		From:                  report.Query.From,
+		To:                    report.Query.To,
+		GroupBy:               groupBy,
+		Rows:                  ToAPISalesReportRows(report.Query.GroupBy, report.Rows),
+		OrdersCreated:         report.OrdersCreated,
+		OrdersPaid:            report.OrdersPaid,
+		ConversionBasisPoints: report.ConversionBasisPoints(),
+	}
+}
+
+// ToAPISalesReportRows converts rows setting only the dimensions in groupBy.
+func ToAPISalesReportRows(groupBy []model.SalesDimension, rows []model.SalesRow) []orderv1.SalesReportRow {
+	apiRows := make([]orderv1.SalesReportRow, 0, len(rows))
+	for _, row := range rows {
+		apiRow := orderv1.SalesReportRow{
+			OrdersPaid:    row.OrdersPaid,
+			Units:         row.Units,
+			RevenueMinor:  row.RevenueMinor,
+			RefundedMinor: row.RefundedMinor,
+		}
+		for _, dimension := range groupBy {
+			switch dimension {
+			case model.SalesDimensionDay:
+				apiRow.Day = orderv1.NewOptDate(row.Day)
+			case model.SalesDimensionPaymentMethod:
+				if row.PaymentMethod != model.PaymentMethodUnspecified {
+					apiRow.PaymentMethod = orderv1.NewOptPaymentMethod(ToAPIPaymentMethod(row.PaymentMethod))
+				}
+			case model.SalesDimensionCategory:
+				if row.Category != model.PartCategoryUnspecified {
+					apiRow.Category = orderv1.NewOptPartCategory(ToAPIPartCategory(row.Category))
+				}
+			case model.SalesDimensionManufacturer:
+				apiRow.Manufacturer = orderv1.NewOptString(row.Manufacturer)
+			}
+		}
+		apiRows = append(apiRows, apiRow)
+	}
+	return apiRows
+}
+
+func ToModelSalesDimension(dimension orderv1.SalesDimension) model.SalesDimension {
+	switch dimension {
+	case orderv1.SalesDimensionDay:
+		return model.SalesDimensionDay
+	case orderv1.SalesDimensionPaymentMethod:
+		return model.SalesDimensionPaymentMethod
+	case orderv1.SalesDimensionCategory:
+		return model.SalesDimensionCategory
+	case orderv1.SalesDimensionManufacturer:
+		return model.SalesDimensionManufacturer
+	default:
+		return model.SalesDimensionUnspecified
+	}
+}
+
+func ToAPISalesDimension(dimension model.SalesDimension) orderv1.SalesDimension {
+	switch dimension {
+	case model.SalesDimensionPaymentMethod:
+		return orderv1.SalesDimensionPaymentMethod
+	case model.SalesDimensionCategory:
+		return orderv1.SalesDimensionCategory
+	case model.SalesDimensionManufacturer:
+		return orderv1.SalesDimensionManufacturer
+	default:
+		return orderv1.SalesDimensionDay
+	}
+}
@@ -0,0 +1,109 @@
+package converter
+
+import (
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderv1 "github.com/qyrlabs/test-backend/shared/pkg/openapi/order/v1"
+)
+
+func ToModelWebhook(req *orderv1.WebhookCreateRequest) *model.Webhook {
+	eventTypes := make([]model.WebhookEventType, 0, len(req.GetEventTypes()))
+	for _, eventType := range req.GetEventTypes() {
+		eventTypes = append(eventTypes, ToModelWebhookEventType(eventType))
+	}
+
+	webhookURL := url.URL(req.GetURL())
+	return &model.Webhook{
+		Url:        webhookURL.String(),
+		EventTypes: eventTypes,
+	}
+}
+
+// ToAPIWebhook converts the webhook without its secret.
+func ToAPIWebhook(webhook *model.Webhook) *orderv1.Webhook {
+	eventTypes := make([]orderv1.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, ToAPIWebhookEventType(eventType))
+	}
+
+	apiWebhook := &orderv1.Webhook{
+		WebhookUUID: uuid.MustParse(webhook.Uuid),
+		EventTypes:  eventTypes,
+		CreatedAt:   webhook.CreatedAt,
+	}
+	// The URL is validated when the webhook is created.
+	if webhookURL, err := url.Parse(webhook.Url); err == nil {
+		apiWebhook.URL = *webhookURL
+	}
+	return apiWebhook
+}
+
+func ToAPIWebhooks(webhooks []*model.Webhook) []orderv1.Webhook {
+	apiWebhooks := make([]orderv1.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		apiWebhooks = append(apiWebhooks, *ToAPIWebhook(webhook))
+	}
+	return apiWebhooks
+}
+
+func ToAPIWebhookEvent(event *model.WebhookEvent) *orderv1.WebhookEvent {
+	return &orderv1.WebhookEvent{
+		EventUUID: uuid.MustParse(event.Uuid),
+		Type:      ToAPIWebhookEventType(event.Type),
+		Order:     *ToAPIOrder(event.Order),
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func ToAPIWebhookDeliveries(deliveries []*model.WebhookDelivery) []orderv1.WebhookDelivery {
+	apiDeliveries := make([]orderv1.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		apiDelivery := orderv1.WebhookDelivery{
+			DeliveryUUID: uuid.MustParse(delivery.Uuid),
+			WebhookUUID:  orderv1.WebhookUUID(uuid.MustParse(delivery.WebhookUuid)),
+			Event:        *ToAPIWebhookEvent(&delivery.Event),
+			Attempts:     delivery.Attempts,
+			LastError:    delivery.LastError,
+		}
+		if delivery.DeadAt != nil {
+			apiDelivery.DeadAt = *delivery.DeadAt
+		}
+		apiDeliveries = append(apiDeliveries, apiDelivery)
+	}
+	return apiDeliveries
+}
+
+func ToModelWebhookEventType(eventType orderv1.WebhookEventType) model.WebhookEventType {
+	switch eventType {
+	case orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED:
+		return model.WebhookEventTypeOrderCreated
+	case orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED:
+		return model.WebhookEventTypeOrderUpdated
+	case orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID:
+		return model.WebhookEventTypeOrderPaid
+	case orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED:
+		return model.WebhookEventTypeOrderCancelled
+	case orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED:
+		return model.WebhookEventTypeOrderRefunded
+	default:
+		return model.WebhookEventTypeUnspecified
+	}
+}
+
+func ToAPIWebhookEventType(eventType model.WebhookEventType) orderv1.WebhookEventType {
+	switch eventType {
+	case model.WebhookEventTypeOrderUpdated:
+		return orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED
+	case model.WebhookEventTypeOrderPaid:
+		return orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID
+	case model.WebhookEventTypeOrderCancelled:
+		return orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED
+	case model.WebhookEventTypeOrderRefunded:
+		return orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED
+	default:
+		return orderv1.WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED
+	}
+}
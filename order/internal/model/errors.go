@@ -0,0 +1,40 @@
+package model
+
+import "errors"
+
+var (
+	ErrOrderNotFound        = errors.New("order not found")
+	ErrOrderAlreadyPaid     = errors.New("order already paid")
+	ErrPartsNotFound        = errors.New("missing specified part uuids")
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrInvalidPaymentMethod = errors.New("invalid payment method")
+	ErrInvalidTenders       = errors.New("invalid tenders")
+	ErrItemsChanged         = errors.New("ordered parts changed in inventory")
+	ErrInvalidCursor        = errors.New("invalid cursor")
+	ErrInvalidRefund        = errors.New("invalid refund")
+	ErrInvalidSalesQuery    = errors.New("invalid sales report query")
+	ErrEmptyOrder           = errors.New("order has no items")
+	ErrPaymentDeadline      = errors.New("payment deadline passed")
+	ErrUnsupportedCountry   = errors.New("shipping to the country is not supported")
+	ErrPromoCodeNotFound    = errors.New("promo code not found")
+	ErrPromoCodeExists      = errors.New("promo code already exists")
+	ErrInvalidPromoCode     = errors.New("invalid promo code")
+	ErrWebhookNotFound      = errors.New("webhook not found")
+	ErrInvalidWebhook       = errors.New("invalid webhook")
+	// ErrPromoCodeNotApplicable is returned when a promo code cannot be applied to an order.
+	ErrPromoCodeNotApplicable = errors.New("promo code not applicable")
+	// ErrOrderChanged is returned by updates when the stored order has changed since it was read.
+	ErrOrderChanged = errors.New("order changed concurrently")
+	// ErrVersionMismatch is returned when the order is not at the version the client expects.
+	ErrVersionMismatch      = errors.New("order version does not match")
+	ErrIdempotencyKeyInUse  = errors.New("request with this idempotency key is in progress")
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+	// ErrTransitionNotAllowed is returned for events not allowed in the current order status.
+	ErrTransitionNotAllowed = errors.New("order status transition not allowed")
+	// ErrUpstream wraps failures of inventory and payment services.
+	ErrUpstream = errors.New("upstream service error")
+	// ErrUpstreamUnavailable is returned when an upstream service is down, overloaded or too slow.
+	ErrUpstreamUnavailable = errors.New("upstream service unavailable")
+	// ErrUpstreamRejected is returned when an upstream service rejects a request as invalid.
+	ErrUpstreamRejected = errors.New("upstream service rejected the request")
+)
@@ -0,0 +1,30 @@
+package model
+
+// Event that moves an Order from one status to another.
+type OrderEvent string
+
+const (
+	OrderEventPay    OrderEvent = "pay"
+	OrderEventCancel OrderEvent = "cancel"
+	// OrderEventPartialPay pays the first tender of a split payment.
+	OrderEventPartialPay OrderEvent = "partial_pay"
+	// OrderEventPayTender pays a tender of a split payment that is neither
+	// the first nor the last one.
+	OrderEventPayTender OrderEvent = "pay_tender"
+	// OrderEventCompletePayment pays the last tender of a split payment.
+	OrderEventCompletePayment OrderEvent = "complete_payment"
+	// OrderEventResumePayment claims a partially paid order again to pay the
+	// rest of a split payment left by a request that did not finish.
+	OrderEventResumePayment OrderEvent = "resume_payment"
+	// OrderEventRevertPayment returns a partially paid order to pending payment
+	// after one of its tenders failed.
+	OrderEventRevertPayment OrderEvent = "revert_payment"
+	// OrderEventEdit changes the items of an order pending payment.
+	OrderEventEdit OrderEvent = "edit"
+	// OrderEventExpire cancels an order not paid before its payment deadline.
+	OrderEventExpire OrderEvent = "expire"
+	// OrderEventRefund refunds the rest of the order.
+	OrderEventRefund OrderEvent = "refund"
+	// OrderEventPartialRefund refunds a part of the order.
+	OrderEventPartialRefund OrderEvent = "partial_refund"
+)
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// StatusTransition is a change of the Order status.
+type StatusTransition struct {
+	// Status before the transition, OrderStatusUnspecified when the order is created.
+	From OrderStatus
+	To   OrderStatus
+	At   time.Time
+	// Who made the transition, see UserActor and ActorSystem.
+	Actor  string
+	Reason string
+}
+
+// ActorSystem is the actor of transitions made by the service itself.
+const ActorSystem = "system"
+
+// UserActor returns the actor of transitions made by the user.
+func UserActor(userUuid string) string {
+	return "user:" + userUuid
+}
+
+// SetStatus moves the order to status, updating lifecycle timestamps and recording the transition.
+func (o *Order) SetStatus(status OrderStatus, at time.Time, actor, reason string) {
+	o.History = append(o.History, StatusTransition{
+		From:   o.Status,
+		To:     status,
+		At:     at,
+		Actor:  actor,
+		Reason: reason,
+	})
+	o.Status = status
+
+	switch status {
+	case OrderStatusPaid:
+		o.PaidAt = &at
+	case OrderStatusCancelled:
+		o.CancelledAt = &at
+	}
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// IdempotencyRecord is a request made with an idempotency key.
+type IdempotencyRecord struct {
+	Key string
+	// Hash of the request method, path and body.
+	Fingerprint string
+	// Stored response, nil while the request is in progress.
+	Response *IdempotentResponse
+	// Time after which the key may be reused.
+	ExpiresAt time.Time
+}
+
+// IdempotentResponse is a response replayed for retries with the same idempotency key.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	// ETag header with the order version the response was made at.
+	ETag string
+	Body []byte
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PriceChangePolicy decides how an Order is paid when prices of its parts
+// changed in inventory since it was priced.
+type PriceChangePolicy int32
+
+const (
+	// PriceChangePolicyHonor charges the prices the order was placed at.
+	PriceChangePolicyHonor PriceChangePolicy = 0
+	// PriceChangePolicyFail rejects the payment.
+	PriceChangePolicyFail PriceChangePolicy = 1
+	// PriceChangePolicyReconfirm reprices the order at current prices and
+	// rejects the payment, paying again confirms the new total.
+	PriceChangePolicyReconfirm PriceChangePolicy = 2
+)
+
+// ParsePriceChangePolicy parses the name of a policy as returned by String.
+func ParsePriceChangePolicy(name string) (PriceChangePolicy, error) {
+	for _, policy := range []PriceChangePolicy{PriceChangePolicyHonor, PriceChangePolicyFail, PriceChangePolicyReconfirm} {
+		if policy.String() == name {
+			return policy, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown price change policy %q, want honor, fail or reconfirm", name)
+}
+
+func (p PriceChangePolicy) String() string {
+	switch p {
+	case PriceChangePolicyFail:
+		return "fail"
+	case PriceChangePolicyReconfirm:
+		return "reconfirm"
+	default:
+		return "honor"
+	}
+}
+
+// ItemChange is a change of an ordered part in inventory found when the Order is paid.
+type ItemChange struct {
+	PartUuid string
+	// Part name when the order was placed.
+	PartName string
+	Kind     ItemChangeKind
+	// Ordered quantity.
+	Quantity int64
+	// Unit price the order was placed at.
+	OrderedPriceMinor int64
+	// Current unit price and stock, zero for a discontinued part.
+	CurrentPriceMinor int64
+	StockQuantity     int64
+}
+
+// Kind of the ItemChange.
+type ItemChangeKind int32
+
+const (
+	ItemChangeKindUnspecified ItemChangeKind = 0
+	// ItemChangeKindDiscontinued is a part no longer in inventory.
+	ItemChangeKindDiscontinued ItemChangeKind = 1
+	// ItemChangeKindOutOfStock is a part with less in stock than ordered.
+	ItemChangeKindOutOfStock ItemChangeKind = 2
+	// ItemChangeKindPriceChanged is a part with another unit price.
+	ItemChangeKindPriceChanged ItemChangeKind = 3
+)
+
+// ItemsChangedError rejects the payment of an Order whose parts changed in
+// inventory. It wraps ErrItemsChanged.
+type ItemsChangedError struct {
+	Changes []ItemChange
+	// The order repriced at current prices for the customer to confirm, nil
+	// unless PriceChangePolicyReconfirm applied.
+	Repriced *Order
+}
+
+func (e *ItemsChangedError) Error() string {
+	details := make([]string, 0, len(e.Changes))
+	for _, change := range e.Changes {
+		switch change.Kind {
+		case ItemChangeKindDiscontinued:
+			details = append(details, fmt.Sprintf("part %s is discontinued", change.PartUuid))
+		case ItemChangeKindOutOfStock:
+			details = append(details, fmt.Sprintf("part %s has %d in stock, %d ordered", change.PartUuid, change.StockQuantity, change.Quantity))
+		case ItemChangeKindPriceChanged:
+			details = append(details, fmt.Sprintf("part %s costs %d instead of %d", change.PartUuid, change.CurrentPriceMinor, change.OrderedPriceMinor))
+		}
+	}
+
+	message := ErrItemsChanged.Error() + ": " + strings.Join(details, ", ")
+	if e.Repriced != nil {
+		message += fmt.Sprintf("; order repriced to %d, pay again to confirm", e.Repriced.TotalPriceMinor)
+	}
+	return message
+}
+
+func (e *ItemsChangedError) Unwrap() error {
+	return ErrItemsChanged
+}
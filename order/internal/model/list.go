@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// OrdersFilter selects orders. Empty fields do not filter.
+type OrdersFilter struct {
+	UserUuid string
+	Statuses []OrderStatus
+	// UUID of a part contained in the order.
+	PartUuid string
+	// Inclusive start of the creation period.
+	CreatedFrom *time.Time
+	// Exclusive end of the creation period.
+	CreatedTo *time.Time
+}
+
+// Field orders are sorted by.
+type OrdersSortBy int32
+
+const (
+	OrdersSortByCreatedAt       OrdersSortBy = 0
+	OrdersSortByTotalPriceMinor OrdersSortBy = 1
+)
+
+// OrdersQuery is a request for a page of orders.
+type OrdersQuery struct {
+	Filter     OrdersFilter
+	SortBy     OrdersSortBy
+	Descending bool
+	Limit      int
+	// Position after which the page starts, nil for the first page.
+	Cursor *OrdersCursor
+}
+
+// OrdersCursor is the position of the last order of a page in the sort order.
+// Orders with equal sort values are ordered by UUID.
+type OrdersCursor struct {
+	SortBy          OrdersSortBy
+	Descending      bool
+	CreatedAt       time.Time
+	TotalPriceMinor int64
+	OrderUuid       string
+}
+
+// OrdersPage is a page of orders.
+type OrdersPage struct {
+	Orders []*Order
+	// Cursor of the next page, nil on the last page.
+	NextCursor *OrdersCursor
+}
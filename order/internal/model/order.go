@@ -0,0 +1,163 @@
+package model
+
+import "time"
+
+type Order struct {
+	// Unique identifier of the order.
+	OrderUuid string
+	// Version of the stored order, incremented by every update. Updates of a
+	// stale version fail, see OrderRepository.Update.
+	Version int64
+	// UUID of the user who placed the order.
+	UserUuid string
+	// Ordered line items.
+	Items []OrderItem
+	// Sum of line totals in minor units.
+	SubtotalMinor int64
+	// Applied promo code, empty if none.
+	PromoCode string
+	// Sum of line discounts in minor units.
+	DiscountMinor int64
+	// Shipping of the order.
+	Shipment Shipment
+	// Taxes by rate, see TaxMinor for the total.
+	Taxes []TaxLine
+	// Sum of line and shipping taxes in minor units.
+	TaxMinor int64
+	// Order total in minor units: SubtotalMinor - DiscountMinor + TaxMinor + Shipment.CostMinor.
+	TotalPriceMinor int64
+	// UUID of the transaction of the first payment, empty until paid.
+	TransactionUuid string
+	// Payment method of the first payment.
+	PaymentMethod PaymentMethod
+	// Tenders the order is being paid or was paid with, see Payments.
+	Tenders []Tender
+	// Payments of the tenders in the order they were charged.
+	Payments []Payment
+	// Sum of payments in minor units. It reaches TotalPriceMinor once the
+	// order is paid and stays below it while the order is partially paid.
+	PaidMinor int64
+	// Number of the current attempt to pay the order. The tenders of an
+	// attempt are charged under keys derived from it, see TenderUuid.
+	PaymentAttempt int64
+	// Start of the payment attempt in progress, nil if none.
+	PaymentStartedAt *time.Time
+	// Order status.
+	Status OrderStatus
+	// Creation timestamp.
+	CreatedAt time.Time
+	// Time after which an unpaid order can no longer be paid and expires.
+	PaymentDeadline time.Time
+	// Payment timestamp, nil until paid.
+	PaidAt *time.Time
+	// Cancellation timestamp, nil unless cancelled.
+	CancelledAt *time.Time
+	// Status transitions in chronological order.
+	History []StatusTransition
+	// Sum of refunded amounts in minor units.
+	RefundedMinor int64
+	// Refunds in chronological order.
+	Refunds []Refund
+	// Events raised by the change being made, see Raise. They are not stored
+	// with the order and are empty in orders read from the repository.
+	Events []WebhookEvent
+}
+
+// Line item of the Order.
+// Part name and unit price are snapshots taken when the order was placed.
+type OrderItem struct {
+	PartUuid       string
+	PartName       string
+	Quantity       int64
+	UnitPriceMinor int64
+	// UnitPriceMinor multiplied by Quantity.
+	LineTotalMinor int64
+	// Promo code discount of the line.
+	DiscountMinor int64
+	// Tax on the discounted line total.
+	TaxMinor int64
+	// Quantity returned to inventory by refunds.
+	RefundedQuantity int64
+}
+
+// OrderRequest describes a new Order.
+type OrderRequest struct {
+	UserUuid string
+	Items    []OrderItemRequest
+	// Promo code to apply, empty if none.
+	PromoCode string
+	// ISO 3166-1 alpha-2 destination country code, the configured default if empty.
+	ShippingCountry string
+}
+
+// OrderUpdateRequest edits the lines of a pending Order. The quantity of each
+// item replaces the ordered one, zero removes the part, and parts not in the
+// order are added.
+type OrderUpdateRequest struct {
+	Items []OrderItemRequest
+}
+
+// Requested line item of a new Order.
+type OrderItemRequest struct {
+	PartUuid string
+	Quantity int64
+}
+
+// Status of the Order.
+type OrderStatus int32
+
+const (
+	OrderStatusUnspecified       OrderStatus = 0
+	OrderStatusPendingPayment    OrderStatus = 1
+	OrderStatusPaid              OrderStatus = 2
+	OrderStatusCancelled         OrderStatus = 3
+	OrderStatusPartiallyRefunded OrderStatus = 4
+	OrderStatusRefunded          OrderStatus = 5
+	// OrderStatusPartiallyPaid is held while tenders of a split payment are charged.
+	OrderStatusPartiallyPaid OrderStatus = 6
+)
+
+// Method used to pay the Order.
+type PaymentMethod int32
+
+const (
+	PaymentMethodUnspecified   PaymentMethod = 0
+	PaymentMethodCard          PaymentMethod = 1
+	PaymentMethodSbp           PaymentMethod = 2
+	PaymentMethodCreditCard    PaymentMethod = 3
+	PaymentMethodInvestorMoney PaymentMethod = 4
+)
+
+func (s OrderStatus) String() string {
+	switch s {
+	case OrderStatusPendingPayment:
+		return "PENDING_PAYMENT"
+	case OrderStatusPartiallyPaid:
+		return "PARTIALLY_PAID"
+	case OrderStatusPaid:
+		return "PAID"
+	case OrderStatusCancelled:
+		return "CANCELLED"
+	case OrderStatusPartiallyRefunded:
+		return "PARTIALLY_REFUNDED"
+	case OrderStatusRefunded:
+		return "REFUNDED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func (m PaymentMethod) String() string {
+	switch m {
+	case PaymentMethodCard:
+		return "CARD"
+	case PaymentMethodSbp:
+		return "SBP"
+	case PaymentMethodCreditCard:
+		return "CREDIT_CARD"
+	case PaymentMethodInvestorMoney:
+		return "INVESTOR_MONEY"
+	default:
+		return "UNSPECIFIED"
+	}
+}
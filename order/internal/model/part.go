@@ -0,0 +1,45 @@
+package model
+
+// Part is a part from the inventory service.
+type Part struct {
+	Uuid          string
+	Name          string
+	PriceMinor    int64
+	StockQuantity int64
+	Category      PartCategory
+	// Name of the manufacturer.
+	Manufacturer string
+}
+
+// PartsExpansion is the current inventory data of the parts of orders.
+type PartsExpansion struct {
+	// Parts by UUID, parts no longer in inventory are missing.
+	Parts map[string]*Part
+	// Inventory could not be read, so no part is expanded.
+	Partial bool
+}
+
+// Category of the Part.
+type PartCategory int32
+
+const (
+	PartCategoryUnspecified PartCategory = 0
+	PartCategoryEngine      PartCategory = 1
+	PartCategoryFuel        PartCategory = 2
+	PartCategoryPorthole    PartCategory = 3
+	PartCategoryWing        PartCategory = 4
+)
+
+// PartsFilter selects parts in the inventory service.
+type PartsFilter struct {
+	Uuids []string
+	// SkipCache reads the current parts from inventory, refreshing cached ones.
+	SkipCache bool
+}
+
+// StockAdjustment is a change of a part stock quantity in the inventory service.
+type StockAdjustment struct {
+	PartUuid string
+	// Quantity to add, negative to take from stock.
+	Delta int64
+}
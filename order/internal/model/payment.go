@@ -0,0 +1,93 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tender is a part of the Order total paid with one payment method.
+type Tender struct {
+	PaymentMethod PaymentMethod
+	AmountMinor   int64
+}
+
+// PayRequest pays an Order with one payment method or splits the total into tenders.
+type PayRequest struct {
+	// Method paying the whole order, unspecified if Tenders are given.
+	PaymentMethod PaymentMethod
+	// Tenders adding up to the order total, charged in the given order.
+	Tenders []Tender
+}
+
+// Payment is a charged Tender of the Order.
+type Payment struct {
+	// UUID of the payment transaction in the payment service.
+	TransactionUuid string
+	PaymentMethod   PaymentMethod
+	// Paid amount in minor units.
+	AmountMinor int64
+	// Amount refunded from the payment in minor units.
+	RefundedMinor int64
+	// Payment timestamp.
+	PaidAt time.Time
+}
+
+// NextTender returns the first tender not paid yet.
+func (o *Order) NextTender() (Tender, bool) {
+	if len(o.Payments) >= len(o.Tenders) {
+		return Tender{}, false
+	}
+	return o.Tenders[len(o.Payments)], true
+}
+
+// AddPayment records the payment of the next tender and updates the paid total.
+// The first payment also sets TransactionUuid and PaymentMethod of the order.
+func (o *Order) AddPayment(payment Payment) {
+	if len(o.Payments) == 0 {
+		o.TransactionUuid = payment.TransactionUuid
+		o.PaymentMethod = payment.PaymentMethod
+	}
+	o.Payments = append(o.Payments, payment)
+	o.PaidMinor += payment.AmountMinor
+}
+
+// SelectTenders sets the tenders the order is to be paid with. Tenders of an
+// attempt that did not finish are kept along with the attempt, so that a retry
+// charges them under the same keys and gets the payments already made. Other
+// tenders start a new attempt.
+func (o *Order) SelectTenders(tenders []Tender) {
+	if slices.Equal(o.Tenders, tenders) {
+		return
+	}
+	o.Tenders = tenders
+	o.PaymentAttempt++
+}
+
+// TenderUuid returns the idempotency key of the tender at index in the current
+// payment attempt. The payment service charges a key once.
+func (o *Order) TenderUuid(index int) string {
+	name := fmt.Sprintf("%s/%d/%d", o.OrderUuid, o.PaymentAttempt, index)
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
+
+// ReleaseUuid returns the idempotency key of the refund of the whole payment
+// made when its payment attempt is reverted.
+func (p *Payment) ReleaseUuid() string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(p.TransactionUuid+"/release")).String()
+}
+
+// ResetPayments forgets the tenders and payments of a payment attempt
+// that was reverted. The payments were refunded, so the next attempt
+// charges under new keys.
+func (o *Order) ResetPayments() {
+	o.Tenders = nil
+	o.Payments = nil
+	o.PaidMinor = 0
+	o.TransactionUuid = ""
+	o.PaymentMethod = PaymentMethodUnspecified
+	o.PaymentAttempt++
+	o.PaymentStartedAt = nil
+}
@@ -0,0 +1,72 @@
+package model
+
+// PricingRules configure taxes and shipping costs of orders.
+type PricingRules struct {
+	// Destination of orders placed without a shipping country.
+	DefaultCountry string
+	TaxRules       []TaxRule
+	ShippingRates  []ShippingRate
+}
+
+// TaxRule is the VAT rate of parts of a category shipped to a country.
+type TaxRule struct {
+	// ISO 3166-1 alpha-2 country code.
+	Country string
+	// Category the rule applies to, PartCategoryUnspecified for the country-wide
+	// rate that also applies to shipping. A category rule wins over the country-wide one.
+	Category PartCategory
+	// Rate in basis points, 2000 is 20%.
+	RateBasisPoints int64
+}
+
+// ShippingRate is the rate table of shipping to a country.
+// The cost is the price of the first bracket holding the chargeable weight.
+// Above the last bracket every started kilogram costs PerExtraKgMinor on top
+// of its price. Shipments with a side longer than OversizeLengthCm cost
+// OversizeSurchargeMinor extra.
+type ShippingRate struct {
+	Country                string
+	Brackets               []ShippingBracket
+	PerExtraKgMinor        int64
+	OversizeLengthCm       float64
+	OversizeSurchargeMinor int64
+}
+
+// ShippingBracket is the price of shipping up to MaxWeightKg.
+type ShippingBracket struct {
+	MaxWeightKg float64
+	PriceMinor  int64
+}
+
+// ShippingInfo is the aggregated size of parts to ship, in kilograms and centimeters.
+type ShippingInfo struct {
+	TotalWeight float64
+	// Cubic centimeters.
+	TotalVolume float64
+	// Max of actual and volumetric weight.
+	ChargeableWeight float64
+	MaxLength        float64
+}
+
+// Shipment is the shipping of an Order.
+type Shipment struct {
+	// ISO 3166-1 alpha-2 destination country code.
+	Country string
+	// Weight the cost is computed from, in kilograms.
+	ChargeableWeightKg float64
+	// Volume of the parts in cubic centimeters.
+	VolumeCm3 float64
+	// Shipping cost before tax.
+	CostMinor int64
+}
+
+// TaxLine sums taxes of an Order charged at the same rate on the same kind of goods.
+type TaxLine struct {
+	// Category of taxed parts, ignored for the shipping tax.
+	Category PartCategory
+	// Whether the line taxes shipping rather than parts.
+	Shipping        bool
+	RateBasisPoints int64
+	TaxableMinor    int64
+	TaxMinor        int64
+}
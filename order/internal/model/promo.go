@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// PromoCode discounts lines of an Order. Discounts are computed in whole minor units:
+//   - a percentage discount is LineTotalMinor * PercentOff / 100 of every eligible line, rounded down;
+//   - a fixed amount is capped at the eligible subtotal and split across eligible lines
+//     in proportion to their totals, rounded down, leftover minor units go to lines in order.
+type PromoCode struct {
+	// Upper-case code entered by users.
+	Code string
+	Kind PromoCodeKind
+	// Percent off eligible lines for PromoCodeKindPercentage, 1 to 100.
+	PercentOff int64
+	// Amount off eligible lines for PromoCodeKindFixedAmount.
+	AmountOffMinor int64
+	ValidFrom      time.Time
+	// End of validity, nil for codes that never expire.
+	ValidUntil *time.Time
+	// Limit of orders using the code in total and per user, 0 for unlimited.
+	MaxUses        int64
+	MaxUsesPerUser int64
+	// Minimal order subtotal before the discount.
+	MinOrderTotalMinor int64
+	// Categories of parts the discount applies to, all if empty.
+	Categories []PartCategory
+	// Number of orders using the code, cancelled orders excluded.
+	UsedCount int64
+	CreatedAt time.Time
+}
+
+// Kind of the PromoCode discount.
+type PromoCodeKind int32
+
+const (
+	PromoCodeKindUnspecified PromoCodeKind = 0
+	PromoCodeKindPercentage  PromoCodeKind = 1
+	PromoCodeKindFixedAmount PromoCodeKind = 2
+)
+
+// Active reports whether the code is within its validity window at t.
+func (p *PromoCode) Active(t time.Time) bool {
+	if t.Before(p.ValidFrom) {
+		return false
+	}
+	return p.ValidUntil == nil || t.Before(*p.ValidUntil)
+}
+
+// Eligible reports whether the discount applies to parts of category.
+func (p *PromoCode) Eligible(category PartCategory) bool {
+	if len(p.Categories) == 0 {
+		return true
+	}
+	for _, c := range p.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
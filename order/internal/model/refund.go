@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Refund returns money and, optionally, parts of a paid Order.
+type Refund struct {
+	// Idempotency key of the refund, saved with the pending refund.
+	Uuid string
+	// UUID of the refund transaction in the payment service, the first one
+	// if the refund is spread over several payments.
+	TransactionUuid string
+	// Refunded amount in minor units.
+	AmountMinor int64
+	// Line items returned to inventory, empty for a money-only refund.
+	Items  []RefundItem
+	Reason string
+	// Refund timestamp.
+	CreatedAt time.Time
+	// Whether the refund is being made. It is saved before money and parts
+	// are returned, so that a concurrent refund of the order fails to save.
+	Pending bool
+}
+
+// PaymentRefundUuid returns the idempotency key of the part of the refund
+// made from the payment with the transaction UUID. The payment service
+// refunds a key once.
+func (r *Refund) PaymentRefundUuid(transactionUuid string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(r.Uuid+"/"+transactionUuid)).String()
+}
+
+// Line item returned to inventory by the Refund.
+type RefundItem struct {
+	PartUuid string
+	Quantity int64
+}
+
+// RefundRequest describes a requested refund. Without items and amount the
+// rest of the order is refunded. Items are refunded at their unit prices
+// and returned to inventory, an amount refunds money only. Refunding the
+// last items refunds the rest of the order, shipping included.
+type RefundRequest struct {
+	Items       []RefundItem
+	AmountMinor int64
+	Reason      string
+}
+
+// RemainingMinor returns the paid amount not refunded yet.
+func (o *Order) RemainingMinor() int64 {
+	return o.TotalPriceMinor - o.RefundedMinor
+}
+
+// PendingRefund reports whether a refund before the last one is being made
+// and was created after since. The last refund is the one being made now.
+func (o *Order) PendingRefund(since time.Time) bool {
+	for _, refund := range o.Refunds[:max(len(o.Refunds)-1, 0)] {
+		if refund.Pending && refund.CreatedAt.After(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddRefund records the refund and updates refunded totals.
+func (o *Order) AddRefund(refund Refund) {
+	o.Refunds = append(o.Refunds, refund)
+	o.RefundedMinor += refund.AmountMinor
+
+	for _, refunded := range refund.Items {
+		for i := range o.Items {
+			if o.Items[i].PartUuid == refunded.PartUuid {
+				o.Items[i].RefundedQuantity += refunded.Quantity
+			}
+		}
+	}
+}
+
+// DropRefund removes the last refund, which was not made, and takes it out of
+// the refunded totals.
+func (o *Order) DropRefund() {
+	refund := o.Refunds[len(o.Refunds)-1]
+	o.Refunds = o.Refunds[:len(o.Refunds)-1]
+	o.RefundedMinor -= refund.AmountMinor
+
+	for _, refunded := range refund.Items {
+		for i := range o.Items {
+			if o.Items[i].PartUuid == refunded.PartUuid {
+				o.Items[i].RefundedQuantity -= refunded.Quantity
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package model
+
+import "time"
+
+// Dimension sales are grouped by.
+type SalesDimension int32
+
+const (
+	SalesDimensionUnspecified   SalesDimension = 0
+	SalesDimensionDay           SalesDimension = 1
+	SalesDimensionPaymentMethod SalesDimension = 2
+	SalesDimensionCategory      SalesDimension = 3
+	SalesDimensionManufacturer  SalesDimension = 4
+)
+
+func (d SalesDimension) String() string {
+	switch d {
+	case SalesDimensionDay:
+		return "day"
+	case SalesDimensionPaymentMethod:
+		return "payment_method"
+	case SalesDimensionCategory:
+		return "category"
+	case SalesDimensionManufacturer:
+		return "manufacturer"
+	default:
+		return "unspecified"
+	}
+}
+
+// SalesQuery is a request for a report on the orders created in a period.
+type SalesQuery struct {
+	// Inclusive start of the period.
+	From time.Time
+	// Exclusive end of the period.
+	To time.Time
+	// Dimensions rows are grouped and sorted by, a single row if empty.
+	GroupBy []SalesDimension
+}
+
+// SalesReport aggregates the orders created in the period of the query.
+// Paid orders bring revenue even if they were refunded later.
+type SalesReport struct {
+	Query SalesQuery
+	// Rows sorted by the dimensions of the query.
+	Rows          []SalesRow
+	OrdersCreated int64
+	OrdersPaid    int64
+}
+
+// ConversionBasisPoints returns the share of created orders that were paid in basis points.
+func (r *SalesReport) ConversionBasisPoints() int64 {
+	if r.OrdersCreated == 0 {
+		return 0
+	}
+	return r.OrdersPaid * 10000 / r.OrdersCreated
+}
+
+// SalesRow is the sales of a group. Only the dimensions the report is grouped by are set.
+type SalesRow struct {
+	// Midnight UTC of the day the orders were created.
+	Day time.Time
+	// Method of the first payment of split payments.
+	PaymentMethod PaymentMethod
+	// Category and manufacturer of parts no longer in inventory are unspecified.
+	Category     PartCategory
+	Manufacturer string
+	// Paid orders with parts in the group.
+	OrdersPaid int64
+	Units      int64
+	// Line totals less discounts, without tax and shipping.
+	RevenueMinor int64
+	// Revenue of the refunded parts.
+	RefundedMinor int64
+}
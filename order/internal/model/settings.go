@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// OrderSettings are the settings of orders that may change while the service
+// runs. They apply to the orders placed and paid from then on.
+type OrderSettings struct {
+	// How long a new order may stay unpaid.
+	PaymentDeadline time.Duration
+	// How payments of orders with parts repriced in inventory are handled.
+	PriceChangePolicy PriceChangePolicy
+}
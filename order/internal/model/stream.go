@@ -0,0 +1,27 @@
+package model
+
+// StatusChange is a status transition of an Order published to live streams.
+type StatusChange struct {
+	// Sequence number of the change, increasing across all orders.
+	ID        uint64
+	OrderUuid string
+	UserUuid  string
+	// Status transition of the order.
+	Transition StatusTransition
+}
+
+// StatusChangeFilter selects status changes of one order or of all orders of a user.
+type StatusChangeFilter struct {
+	// UUID of the order, any order if empty.
+	OrderUuid string
+	// UUID of the user, any user if empty.
+	UserUuid string
+}
+
+// Matches reports whether the change passes the filter.
+func (f StatusChangeFilter) Matches(change StatusChange) bool {
+	if f.OrderUuid != "" && f.OrderUuid != change.OrderUuid {
+		return false
+	}
+	return f.UserUuid == "" || f.UserUuid == change.UserUuid
+}
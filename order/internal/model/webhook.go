@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// Webhook subscribes an HTTP endpoint to order events.
+type Webhook struct {
+	Uuid string
+	// Endpoint events are POSTed to.
+	Url string
+	// Subscribed event types, all if empty.
+	EventTypes []WebhookEventType
+	// Key of the HMAC-SHA256 signature of deliveries.
+	Secret    string
+	CreatedAt time.Time
+}
+
+// Subscribed reports whether events of eventType are delivered to the webhook.
+func (w *Webhook) Subscribed(eventType WebhookEventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent notifies webhooks of an order change. Events raised on an Order
+// are stored in the outbox together with the order.
+type WebhookEvent struct {
+	Uuid string
+	Type WebhookEventType
+	// Order as stored by the change, nil until the event is stored.
+	Order     *Order
+	CreatedAt time.Time
+}
+
+// Type of the WebhookEvent.
+type WebhookEventType int32
+
+const (
+	WebhookEventTypeUnspecified    WebhookEventType = 0
+	WebhookEventTypeOrderCreated   WebhookEventType = 1
+	WebhookEventTypeOrderUpdated   WebhookEventType = 2
+	WebhookEventTypeOrderPaid      WebhookEventType = 3
+	WebhookEventTypeOrderCancelled WebhookEventType = 4
+	WebhookEventTypeOrderRefunded  WebhookEventType = 5
+)
+
+// WebhookDelivery is a WebhookEvent to be delivered to one Webhook.
+type WebhookDelivery struct {
+	Uuid        string
+	WebhookUuid string
+	Event       WebhookEvent
+	// Number of failed attempts.
+	Attempts int64
+	// Time of the next attempt.
+	NextAttemptAt time.Time
+	// Error of the last failed attempt, empty before the first one.
+	LastError string
+	// Time the delivery was given up and dead-lettered, nil while it is retried.
+	DeadAt *time.Time
+}
+
+// Raise records an event of the change being made to the order. The event is
+// stored in the outbox when the order is saved.
+func (o *Order) Raise(eventType WebhookEventType, at time.Time) {
+	o.Events = append(o.Events, WebhookEvent{
+		Type:      eventType,
+		CreatedAt: at,
+	})
+}
@@ -0,0 +1,76 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Discount applies the promo code to eligible lines as documented on model.PromoCode.
+func Discount(ctx context.Context, quote *Quote) error {
+	order, promo := quote.Order, quote.PromoCode
+	if promo == nil {
+		return nil
+	}
+
+	if !promo.Active(quote.At) {
+		return fmt.Errorf("%w: %s is not active", model.ErrPromoCodeNotApplicable, promo.Code)
+	}
+	if order.SubtotalMinor < promo.MinOrderTotalMinor {
+		return fmt.Errorf("%w: %s requires an order total of at least %d", model.ErrPromoCodeNotApplicable, promo.Code, promo.MinOrderTotalMinor)
+	}
+
+	eligible := make([]int, 0, len(order.Items))
+	var eligibleMinor int64
+	for i, item := range order.Items {
+		if promo.Eligible(category(quote, item.PartUuid)) {
+			eligible = append(eligible, i)
+			eligibleMinor += item.LineTotalMinor
+		}
+	}
+	if len(eligible) == 0 {
+		return fmt.Errorf("%w: %s does not apply to any ordered part", model.ErrPromoCodeNotApplicable, promo.Code)
+	}
+
+	switch promo.Kind {
+	case model.PromoCodeKindPercentage:
+		for _, i := range eligible {
+			order.Items[i].DiscountMinor = order.Items[i].LineTotalMinor * promo.PercentOff / 100
+		}
+	case model.PromoCodeKindFixedAmount:
+		amount := min(promo.AmountOffMinor, eligibleMinor)
+		allocated := int64(0)
+		for _, i := range eligible {
+			if eligibleMinor == 0 {
+				break
+			}
+			order.Items[i].DiscountMinor = amount * order.Items[i].LineTotalMinor / eligibleMinor
+			allocated += order.Items[i].DiscountMinor
+		}
+		// Rounding down leaves less than one minor unit per line.
+		for _, i := range eligible {
+			if allocated == amount {
+				break
+			}
+			if order.Items[i].DiscountMinor < order.Items[i].LineTotalMinor {
+				order.Items[i].DiscountMinor++
+				allocated++
+			}
+		}
+	}
+
+	order.PromoCode = promo.Code
+	for _, item := range order.Items {
+		order.DiscountMinor += item.DiscountMinor
+	}
+
+	return nil
+}
+
+func category(quote *Quote, partUuid string) model.PartCategory {
+	if part, ok := quote.Parts[partUuid]; ok {
+		return part.Category
+	}
+	return model.PartCategoryUnspecified
+}
@@ -0,0 +1,164 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// line is an ordered line of a test quote.
+type line struct {
+	category       model.PartCategory
+	lineTotalMinor int64
+}
+
+// newQuote returns a quote of lines priced by Subtotal, one part per line.
+func newQuote(promo *model.PromoCode, lines ...line) *Quote {
+	quote := &Quote{
+		Order:     &model.Order{Shipment: model.Shipment{Country: "RU"}},
+		Parts:     make(map[string]*model.Part, len(lines)),
+		PromoCode: promo,
+		At:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for i, l := range lines {
+		uuid := string(rune('a' + i))
+		quote.Order.Items = append(quote.Order.Items, model.OrderItem{PartUuid: uuid, Quantity: 1, LineTotalMinor: l.lineTotalMinor})
+		quote.Order.SubtotalMinor += l.lineTotalMinor
+		quote.Parts[uuid] = &model.Part{Uuid: uuid, Category: l.category}
+	}
+	return quote
+}
+
+func TestDiscount(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	percent := func(off int64, categories ...model.PartCategory) *model.PromoCode {
+		return &model.PromoCode{Code: "PERCENT", Kind: model.PromoCodeKindPercentage, PercentOff: off, Categories: categories, ValidFrom: at}
+	}
+	fixed := func(offMinor int64, categories ...model.PartCategory) *model.PromoCode {
+		return &model.PromoCode{Code: "FIXED", Kind: model.PromoCodeKindFixedAmount, AmountOffMinor: offMinor, Categories: categories, ValidFrom: at}
+	}
+
+	tests := []struct {
+		name  string
+		promo *model.PromoCode
+		lines []line
+		// Discount of each line in minor units.
+		want []int64
+	}{
+		{
+			name:  "no promo code",
+			lines: []line{{model.PartCategoryEngine, 1000}},
+			want:  []int64{0},
+		},
+		{
+			name:  "percentage of a single line",
+			promo: percent(15),
+			lines: []line{{model.PartCategoryEngine, 1000}},
+			want:  []int64{150},
+		},
+		{
+			name:  "percentage rounded down per line",
+			promo: percent(15),
+			lines: []line{{model.PartCategoryEngine, 999}, {model.PartCategoryWing, 1}},
+			want:  []int64{149, 0},
+		},
+		{
+			name:  "percentage of eligible categories",
+			promo: percent(10, model.PartCategoryEngine),
+			lines: []line{{model.PartCategoryEngine, 1000}, {model.PartCategoryFuel, 2000}, {model.PartCategoryEngine, 500}},
+			want:  []int64{100, 0, 50},
+		},
+		{
+			name:  "fixed amount of a single line",
+			promo: fixed(300),
+			lines: []line{{model.PartCategoryEngine, 1000}},
+			want:  []int64{300},
+		},
+		{
+			name:  "fixed amount split by line totals",
+			promo: fixed(300),
+			lines: []line{{model.PartCategoryEngine, 1000}, {model.PartCategoryWing, 2000}},
+			want:  []int64{100, 200},
+		},
+		{
+			name:  "fixed amount leftover goes to lines in order",
+			promo: fixed(100),
+			lines: []line{{model.PartCategoryEngine, 1000}, {model.PartCategoryWing, 1000}, {model.PartCategoryFuel, 1000}},
+			want:  []int64{34, 33, 33},
+		},
+		{
+			name:  "fixed amount leftover skips ineligible lines",
+			promo: fixed(200, model.PartCategoryWing),
+			lines: []line{{model.PartCategoryEngine, 1000}, {model.PartCategoryWing, 1000}, {model.PartCategoryFuel, 1000}, {model.PartCategoryWing, 1000}, {model.PartCategoryWing, 1000}},
+			want:  []int64{0, 67, 0, 67, 66},
+		},
+		{
+			name:  "fixed amount above eligible subtotal",
+			promo: fixed(5000, model.PartCategoryEngine),
+			lines: []line{{model.PartCategoryEngine, 700}, {model.PartCategoryFuel, 2000}, {model.PartCategoryEngine, 500}},
+			want:  []int64{700, 0, 500},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := newQuote(tt.promo, tt.lines...)
+			if err := Discount(context.Background(), quote); err != nil {
+				t.Fatalf("discount: %v", err)
+			}
+
+			var got []int64
+			var total int64
+			for _, item := range quote.Order.Items {
+				got = append(got, item.DiscountMinor)
+				total += item.DiscountMinor
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("line discounts are %v, want %v", got, tt.want)
+			}
+			if quote.Order.DiscountMinor != total {
+				t.Errorf("order discount is %d, lines sum up to %d", quote.Order.DiscountMinor, total)
+			}
+		})
+	}
+}
+
+func TestDiscountNotApplicable(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expired := at.Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		promo *model.PromoCode
+	}{
+		{
+			name:  "not valid yet",
+			promo: &model.PromoCode{Code: "SOON", Kind: model.PromoCodeKindPercentage, PercentOff: 10, ValidFrom: at.Add(time.Hour)},
+		},
+		{
+			name:  "expired",
+			promo: &model.PromoCode{Code: "OLD", Kind: model.PromoCodeKindPercentage, PercentOff: 10, ValidFrom: at.Add(-2 * time.Hour), ValidUntil: &expired},
+		},
+		{
+			name:  "order total below minimum",
+			promo: &model.PromoCode{Code: "BIG", Kind: model.PromoCodeKindPercentage, PercentOff: 10, ValidFrom: at, MinOrderTotalMinor: 1001},
+		},
+		{
+			name:  "no eligible lines",
+			promo: &model.PromoCode{Code: "WINGS", Kind: model.PromoCodeKindFixedAmount, AmountOffMinor: 100, ValidFrom: at, Categories: []model.PartCategory{model.PartCategoryWing}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := newQuote(tt.promo, line{model.PartCategoryEngine, 1000})
+			if err := Discount(context.Background(), quote); !errors.Is(err, model.ErrPromoCodeNotApplicable) {
+				t.Fatalf("got %v, want %v", err, model.ErrPromoCodeNotApplicable)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Package pricing prices orders by running a pipeline of stages, each adding
+// its part of the price breakdown. All amounts are integer minor units.
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Quote is an order being priced together with inputs of the stages.
+type Quote struct {
+	Order *model.Order
+	// Ordered parts by UUID.
+	Parts map[string]*model.Part
+	// Promo code to apply, nil if none.
+	PromoCode *model.PromoCode
+	// Time the order is priced at.
+	At time.Time
+}
+
+// Stage adds its part of the price breakdown to the quoted order.
+type Stage func(ctx context.Context, quote *Quote) error
+
+type Pipeline struct {
+	stages []Stage
+}
+
+// New creates a pipeline running stages in the given order.
+func New(stages ...Stage) *Pipeline {
+	return &Pipeline{
+		stages: stages,
+	}
+}
+
+// Price clears the price breakdown of the quoted order, runs the stages and
+// sums up the total. Line quantities, unit prices and the shipping country
+// must be set beforehand.
+func (p *Pipeline) Price(ctx context.Context, quote *Quote) error {
+	order := quote.Order
+	for i := range order.Items {
+		order.Items[i].LineTotalMinor = 0
+		order.Items[i].DiscountMinor = 0
+		order.Items[i].TaxMinor = 0
+	}
+	order.SubtotalMinor = 0
+	order.PromoCode = ""
+	order.DiscountMinor = 0
+	order.Shipment = model.Shipment{Country: order.Shipment.Country}
+	order.Taxes = nil
+	order.TaxMinor = 0
+
+	for _, stage := range p.stages {
+		if err := stage(ctx, quote); err != nil {
+			return err
+		}
+	}
+
+	order.TotalPriceMinor = order.SubtotalMinor - order.DiscountMinor + order.TaxMinor + order.Shipment.CostMinor
+	return nil
+}
@@ -0,0 +1,181 @@
+package pricing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// DefaultRules returns pricing rules used when no rules file is given.
+func DefaultRules() model.PricingRules {
+	return model.PricingRules{
+		DefaultCountry: "RU",
+		TaxRules: []model.TaxRule{
+			{Country: "RU", RateBasisPoints: 2000},
+			{Country: "RU", Category: model.PartCategoryFuel, RateBasisPoints: 1000},
+			{Country: "DE", RateBasisPoints: 1900},
+			{Country: "KZ", RateBasisPoints: 1200},
+		},
+		ShippingRates: []model.ShippingRate{
+			{
+				Country: "RU",
+				Brackets: []model.ShippingBracket{
+					{MaxWeightKg: 5, PriceMinor: 50_000},
+					{MaxWeightKg: 30, PriceMinor: 150_000},
+					{MaxWeightKg: 100, PriceMinor: 400_000},
+				},
+				PerExtraKgMinor:        3_000,
+				OversizeLengthCm:       150,
+				OversizeSurchargeMinor: 100_000,
+			},
+			{
+				Country: "KZ",
+				Brackets: []model.ShippingBracket{
+					{MaxWeightKg: 5, PriceMinor: 90_000},
+					{MaxWeightKg: 30, PriceMinor: 250_000},
+					{MaxWeightKg: 100, PriceMinor: 650_000},
+				},
+				PerExtraKgMinor:        5_000,
+				OversizeLengthCm:       150,
+				OversizeSurchargeMinor: 150_000,
+			},
+			{
+				Country: "DE",
+				Brackets: []model.ShippingBracket{
+					{MaxWeightKg: 5, PriceMinor: 250_000},
+					{MaxWeightKg: 30, PriceMinor: 600_000},
+					{MaxWeightKg: 100, PriceMinor: 1_500_000},
+				},
+				PerExtraKgMinor:        12_000,
+				OversizeLengthCm:       120,
+				OversizeSurchargeMinor: 300_000,
+			},
+		},
+	}
+}
+
+// rulesFile is the JSON form of model.PricingRules.
+type rulesFile struct {
+	DefaultCountry string `json:"default_country"`
+	TaxRules       []struct {
+		Country string `json:"country"`
+		// Inventory category name, such as CATEGORY_FUEL, empty for the country-wide rate.
+		Category        string `json:"category"`
+		RateBasisPoints int64  `json:"rate_basis_points"`
+	} `json:"tax_rules"`
+	ShippingRates []struct {
+		Country  string `json:"country"`
+		Brackets []struct {
+			MaxWeightKg float64 `json:"max_weight_kg"`
+			PriceMinor  int64   `json:"price_minor"`
+		} `json:"brackets"`
+		PerExtraKgMinor        int64   `json:"per_extra_kg_minor"`
+		OversizeLengthCm       float64 `json:"oversize_length_cm"`
+		OversizeSurchargeMinor int64   `json:"oversize_surcharge_minor"`
+	} `json:"shipping_rates"`
+}
+
+// LoadRules reads pricing rules from a JSON file.
+func LoadRules(path string) (model.PricingRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return model.PricingRules{}, err
+	}
+
+	var file rulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return model.PricingRules{}, fmt.Errorf("failed to parse pricing rules: %w", err)
+	}
+
+	rules := model.PricingRules{
+		DefaultCountry: file.DefaultCountry,
+	}
+	for _, tax := range file.TaxRules {
+		category, ok := categories[tax.Category]
+		if !ok {
+			return model.PricingRules{}, fmt.Errorf("unknown category %q in tax rules", tax.Category)
+		}
+		rules.TaxRules = append(rules.TaxRules, model.TaxRule{
+			Country:         tax.Country,
+			Category:        category,
+			RateBasisPoints: tax.RateBasisPoints,
+		})
+	}
+	for _, rate := range file.ShippingRates {
+		shippingRate := model.ShippingRate{
+			Country:                rate.Country,
+			PerExtraKgMinor:        rate.PerExtraKgMinor,
+			OversizeLengthCm:       rate.OversizeLengthCm,
+			OversizeSurchargeMinor: rate.OversizeSurchargeMinor,
+		}
+		for _, bracket := range rate.Brackets {
+			shippingRate.Brackets = append(shippingRate.Brackets, model.ShippingBracket{
+				MaxWeightKg: bracket.MaxWeightKg,
+				PriceMinor:  bracket.PriceMinor,
+			})
+		}
+		rules.ShippingRates = append(rules.ShippingRates, shippingRate)
+	}
+
+	if err := ValidateRules(rules); err != nil {
+		return model.PricingRules{}, err
+	}
+	return rules, nil
+}
+
+var categories = map[string]model.PartCategory{
+	"":                  model.PartCategoryUnspecified,
+	"CATEGORY_ENGINE":   model.PartCategoryEngine,
+	"CATEGORY_FUEL":     model.PartCategoryFuel,
+	"CATEGORY_PORTHOLE": model.PartCategoryPorthole,
+	"CATEGORY_WING":     model.PartCategoryWing,
+}
+
+// ValidateRules checks that rules are consistent and orders to the default country can be shipped.
+func ValidateRules(rules model.PricingRules) error {
+	var errs []error
+
+	shipped := make(map[string]bool, len(rules.ShippingRates))
+	for _, rate := range rules.ShippingRates {
+		if !countryPattern.MatchString(rate.Country) {
+			errs = append(errs, fmt.Errorf("invalid shipping rate country %q", rate.Country))
+		}
+		if shipped[rate.Country] {
+			errs = append(errs, fmt.Errorf("duplicate shipping rate for %s", rate.Country))
+		}
+		shipped[rate.Country] = true
+
+		if len(rate.Brackets) == 0 {
+			errs = append(errs, fmt.Errorf("shipping rate for %s has no brackets", rate.Country))
+		}
+		for i, bracket := range rate.Brackets {
+			if i > 0 && bracket.MaxWeightKg <= rate.Brackets[i-1].MaxWeightKg {
+				errs = append(errs, fmt.Errorf("shipping brackets for %s must have increasing weights", rate.Country))
+			}
+			if bracket.PriceMinor < 0 {
+				errs = append(errs, fmt.Errorf("shipping bracket price for %s must not be negative", rate.Country))
+			}
+		}
+	}
+
+	for _, rule := range rules.TaxRules {
+		if !countryPattern.MatchString(rule.Country) {
+			errs = append(errs, fmt.Errorf("invalid tax rule country %q", rule.Country))
+		}
+		if rule.RateBasisPoints < 0 || rule.RateBasisPoints > basisPoints {
+			errs = append(errs, fmt.Errorf("tax rate for %s must be between 0 and %d basis points", rule.Country, basisPoints))
+		}
+	}
+
+	if !shipped[rules.DefaultCountry] {
+		errs = append(errs, fmt.Errorf("no shipping rate for default country %q", rules.DefaultCountry))
+	}
+
+	return errors.Join(errs...)
+}
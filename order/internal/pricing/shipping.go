@@ -0,0 +1,65 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// ShippingInfoGetter returns the aggregated size of parts to ship.
+type ShippingInfoGetter interface {
+	GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error)
+}
+
+// Shipping returns a stage pricing shipping to the order country by the rate
+// tables, see model.ShippingRate. Volume is accounted for through the
+// volumetric weight included in the chargeable weight.
+func Shipping(rates []model.ShippingRate, inventory ShippingInfoGetter) Stage {
+	byCountry := make(map[string]model.ShippingRate, len(rates))
+	for _, rate := range rates {
+		byCountry[rate.Country] = rate
+	}
+
+	return func(ctx context.Context, quote *Quote) error {
+		order := quote.Order
+		rate, ok := byCountry[order.Shipment.Country]
+		if !ok {
+			return fmt.Errorf("%w: %s", model.ErrUnsupportedCountry, order.Shipment.Country)
+		}
+
+		items := make([]model.OrderItemRequest, 0, len(order.Items))
+		for _, item := range order.Items {
+			items = append(items, model.OrderItemRequest{PartUuid: item.PartUuid, Quantity: item.Quantity})
+		}
+		info, err := inventory.GetShippingInfo(ctx, items)
+		if err != nil {
+			return fmt.Errorf("%w: failed to get shipping info: %w", model.ErrUpstream, err)
+		}
+
+		order.Shipment.ChargeableWeightKg = info.ChargeableWeight
+		order.Shipment.VolumeCm3 = info.TotalVolume
+		order.Shipment.CostMinor = shippingCost(rate, info)
+		return nil
+	}
+}
+
+func shippingCost(rate model.ShippingRate, info *model.ShippingInfo) int64 {
+	var cost int64
+	if len(rate.Brackets) > 0 {
+		last := rate.Brackets[len(rate.Brackets)-1]
+		cost = last.PriceMinor + int64(math.Ceil(info.ChargeableWeight-last.MaxWeightKg))*rate.PerExtraKgMinor
+		for _, bracket := range rate.Brackets {
+			if info.ChargeableWeight <= bracket.MaxWeightKg {
+				cost = bracket.PriceMinor
+				break
+			}
+		}
+	}
+
+	if rate.OversizeLengthCm > 0 && info.MaxLength > rate.OversizeLengthCm {
+		cost += rate.OversizeSurchargeMinor
+	}
+	return cost
+}
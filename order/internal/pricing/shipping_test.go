@@ -0,0 +1,144 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// fakeShippingInfo returns the shipping info it holds or fails with err.
+type fakeShippingInfo struct {
+	info *model.ShippingInfo
+	err  error
+}
+
+func (f *fakeShippingInfo) GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error) {
+	return f.info, f.err
+}
+
+func TestShipping(t *testing.T) {
+	rates := []model.ShippingRate{{
+		Country: "RU",
+		Brackets: []model.ShippingBracket{
+			{MaxWeightKg: 5, PriceMinor: 50_000},
+			{MaxWeightKg: 30, PriceMinor: 150_000},
+			{MaxWeightKg: 100, PriceMinor: 400_000},
+		},
+		PerExtraKgMinor:        3_000,
+		OversizeLengthCm:       150,
+		OversizeSurchargeMinor: 100_000,
+	}}
+
+	tests := []struct {
+		name string
+		info model.ShippingInfo
+		want int64
+	}{
+		{
+			name: "no weight",
+			want: 50_000,
+		},
+		{
+			name: "actual weight at bracket edge",
+			info: model.ShippingInfo{TotalWeight: 5, ChargeableWeight: 5},
+			want: 50_000,
+		},
+		{
+			name: "actual weight above bracket edge",
+			info: model.ShippingInfo{TotalWeight: 5.001, ChargeableWeight: 5.001},
+			want: 150_000,
+		},
+		{
+			name: "volumetric weight selects bracket",
+			info: model.ShippingInfo{TotalWeight: 2, TotalVolume: 60_000, ChargeableWeight: 12},
+			want: 150_000,
+		},
+		{
+			name: "volumetric weight at bracket edge",
+			info: model.ShippingInfo{TotalWeight: 1, TotalVolume: 150_000, ChargeableWeight: 30},
+			want: 150_000,
+		},
+		{
+			name: "actual weight above volumetric weight",
+			info: model.ShippingInfo{TotalWeight: 31, TotalVolume: 5_000, ChargeableWeight: 31},
+			want: 400_000,
+		},
+		{
+			name: "last bracket edge",
+			info: model.ShippingInfo{TotalWeight: 100, ChargeableWeight: 100},
+			want: 400_000,
+		},
+		{
+			name: "part of extra kilogram",
+			info: model.ShippingInfo{TotalWeight: 100.2, ChargeableWeight: 100.2},
+			want: 403_000,
+		},
+		{
+			name: "whole extra kilograms",
+			info: model.ShippingInfo{TotalWeight: 102, ChargeableWeight: 102},
+			want: 406_000,
+		},
+		{
+			name: "length at oversize edge",
+			info: model.ShippingInfo{TotalWeight: 3, ChargeableWeight: 3, MaxLength: 150},
+			want: 50_000,
+		},
+		{
+			name: "oversize",
+			info: model.ShippingInfo{TotalWeight: 3, ChargeableWeight: 3, MaxLength: 150.5},
+			want: 150_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := &Quote{Order: &model.Order{Shipment: model.Shipment{Country: "RU"}}}
+			if err := Shipping(rates, &fakeShippingInfo{info: &tt.info})(context.Background(), quote); err != nil {
+				t.Fatalf("shipping: %v", err)
+			}
+
+			shipment := quote.Order.Shipment
+			if shipment.CostMinor != tt.want {
+				t.Errorf("shipping costs %d, want %d", shipment.CostMinor, tt.want)
+			}
+			if shipment.ChargeableWeightKg != tt.info.ChargeableWeight || shipment.VolumeCm3 != tt.info.TotalVolume {
+				t.Errorf("shipment of %v kg, %v cm3, want %v kg, %v cm3", shipment.ChargeableWeightKg, shipment.VolumeCm3, tt.info.ChargeableWeight, tt.info.TotalVolume)
+			}
+		})
+	}
+}
+
+func TestShippingFails(t *testing.T) {
+	rates := []model.ShippingRate{{Country: "RU", Brackets: []model.ShippingBracket{{MaxWeightKg: 5, PriceMinor: 50_000}}}}
+
+	tests := []struct {
+		name      string
+		country   string
+		inventory *fakeShippingInfo
+		want      error
+	}{
+		{
+			name:      "unsupported country",
+			country:   "FR",
+			inventory: &fakeShippingInfo{info: &model.ShippingInfo{}},
+			want:      model.ErrUnsupportedCountry,
+		},
+		{
+			name:      "inventory failure",
+			country:   "RU",
+			inventory: &fakeShippingInfo{err: errors.New("unavailable")},
+			want:      model.ErrUpstream,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := &Quote{Order: &model.Order{Shipment: model.Shipment{Country: tt.country}}}
+			if err := Shipping(rates, tt.inventory)(context.Background(), quote); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package pricing
+
+import (
+	"context"
+)
+
+// Subtotal prices lines at their unit prices.
+func Subtotal(ctx context.Context, quote *Quote) error {
+	order := quote.Order
+	for i := range order.Items {
+		item := &order.Items[i]
+		item.LineTotalMinor = item.UnitPriceMinor * item.Quantity
+		order.SubtotalMinor += item.LineTotalMinor
+	}
+	return nil
+}
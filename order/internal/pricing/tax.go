@@ -0,0 +1,79 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// basisPoints in 100%.
+const basisPoints = 10_000
+
+type taxKey struct {
+	country  string
+	category model.PartCategory
+}
+
+// Tax returns a stage charging VAT on discounted lines and on shipping by the
+// rules of the order country, see model.TaxRule. Prices are net of tax, the
+// tax of every line and of shipping is rounded half up to a whole minor unit.
+// Countries without rules are not taxed.
+func Tax(rules []model.TaxRule) Stage {
+	rates := make(map[taxKey]int64, len(rules))
+	for _, rule := range rules {
+		rates[taxKey{rule.Country, rule.Category}] = rule.RateBasisPoints
+	}
+
+	rate := func(country string, category model.PartCategory) int64 {
+		if r, ok := rates[taxKey{country, category}]; ok {
+			return r
+		}
+		return rates[taxKey{country, model.PartCategoryUnspecified}]
+	}
+
+	return func(ctx context.Context, quote *Quote) error {
+		order := quote.Order
+		country := order.Shipment.Country
+
+		lines := make(map[model.TaxLine]int)
+		add := func(line model.TaxLine) {
+			if line.TaxableMinor == 0 {
+				return
+			}
+			key := model.TaxLine{Category: line.Category, Shipping: line.Shipping, RateBasisPoints: line.RateBasisPoints}
+			i, ok := lines[key]
+			if !ok {
+				i = len(order.Taxes)
+				lines[key] = i
+				order.Taxes = append(order.Taxes, key)
+			}
+			order.Taxes[i].TaxableMinor += line.TaxableMinor
+			order.Taxes[i].TaxMinor += line.TaxMinor
+			order.TaxMinor += line.TaxMinor
+		}
+
+		for i := range order.Items {
+			item := &order.Items[i]
+			c := category(quote, item.PartUuid)
+			r := rate(country, c)
+			taxable := item.LineTotalMinor - item.DiscountMinor
+			item.TaxMinor = percentOf(taxable, r)
+			add(model.TaxLine{Category: c, RateBasisPoints: r, TaxableMinor: taxable, TaxMinor: item.TaxMinor})
+		}
+
+		r := rate(country, model.PartCategoryUnspecified)
+		add(model.TaxLine{
+			Shipping:        true,
+			RateBasisPoints: r,
+			TaxableMinor:    order.Shipment.CostMinor,
+			TaxMinor:        percentOf(order.Shipment.CostMinor, r),
+		})
+
+		return nil
+	}
+}
+
+// percentOf returns rate basis points of a non-negative amount, rounded half up.
+func percentOf(amount, rate int64) int64 {
+	return (amount*rate + basisPoints/2) / basisPoints
+}
@@ -0,0 +1,153 @@
+package pricing
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func TestPercentOf(t *testing.T) {
+	tests := []struct {
+		amount, rate, want int64
+	}{
+		{amount: 0, rate: 2000, want: 0},
+		{amount: 4, rate: 1000, want: 0},
+		{amount: 5, rate: 1000, want: 1},
+		{amount: 14, rate: 1000, want: 1},
+		{amount: 15, rate: 1000, want: 2},
+		{amount: 25, rate: 1000, want: 3},
+		{amount: 1, rate: 5000, want: 1},
+		{amount: 49, rate: 1900, want: 9},
+		{amount: 50, rate: 1900, want: 10},
+		{amount: 1_000_000, rate: 2000, want: 200_000},
+	}
+
+	for _, tt := range tests {
+		if got := percentOf(tt.amount, tt.rate); got != tt.want {
+			t.Errorf("%d bp of %d is %d, want %d", tt.rate, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestTax(t *testing.T) {
+	rules := []model.TaxRule{
+		{Country: "RU", RateBasisPoints: 2000},
+		{Country: "RU", Category: model.PartCategoryFuel, RateBasisPoints: 1000},
+		{Country: "DE", RateBasisPoints: 1900},
+	}
+
+	// taxedLine is a line of a test order.
+	type taxedLine struct {
+		category                      model.PartCategory
+		lineTotalMinor, discountMinor int64
+	}
+
+	tests := []struct {
+		name         string
+		country      string
+		lines        []taxedLine
+		shippingCost int64
+		// Tax of each line in minor units.
+		wantLines []int64
+		wantTaxes []model.TaxLine
+	}{
+		{
+			name:      "country-wide rate",
+			country:   "RU",
+			lines:     []taxedLine{{model.PartCategoryEngine, 1000, 0}},
+			wantLines: []int64{200},
+			wantTaxes: []model.TaxLine{
+				{Category: model.PartCategoryEngine, RateBasisPoints: 2000, TaxableMinor: 1000, TaxMinor: 200},
+			},
+		},
+		{
+			name:      "category rate rounded half up",
+			country:   "RU",
+			lines:     []taxedLine{{model.PartCategoryFuel, 1005, 0}, {model.PartCategoryFuel, 1004, 0}},
+			wantLines: []int64{101, 100},
+			wantTaxes: []model.TaxLine{
+				{Category: model.PartCategoryFuel, RateBasisPoints: 1000, TaxableMinor: 2009, TaxMinor: 201},
+			},
+		},
+		{
+			name:      "discounted lines",
+			country:   "DE",
+			lines:     []taxedLine{{model.PartCategoryWing, 1050, 1000}, {model.PartCategoryWing, 1049, 1000}},
+			wantLines: []int64{10, 9},
+			wantTaxes: []model.TaxLine{
+				{Category: model.PartCategoryWing, RateBasisPoints: 1900, TaxableMinor: 99, TaxMinor: 19},
+			},
+		},
+		{
+			name:         "shipping at country-wide rate",
+			country:      "RU",
+			lines:        []taxedLine{{model.PartCategoryFuel, 1000, 0}, {model.PartCategoryEngine, 3000, 0}},
+			shippingCost: 50_002,
+			wantLines:    []int64{100, 600},
+			wantTaxes: []model.TaxLine{
+				{Category: model.PartCategoryFuel, RateBasisPoints: 1000, TaxableMinor: 1000, TaxMinor: 100},
+				{Category: model.PartCategoryEngine, RateBasisPoints: 2000, TaxableMinor: 3000, TaxMinor: 600},
+				{Shipping: true, RateBasisPoints: 2000, TaxableMinor: 50_002, TaxMinor: 10_000},
+			},
+		},
+		{
+			name:         "shipping rounded half up",
+			country:      "DE",
+			lines:        []taxedLine{{model.PartCategoryEngine, 1000, 1000}},
+			shippingCost: 50,
+			wantLines:    []int64{0},
+			wantTaxes: []model.TaxLine{
+				{Shipping: true, RateBasisPoints: 1900, TaxableMinor: 50, TaxMinor: 10},
+			},
+		},
+		{
+			name:         "country without rules",
+			country:      "KZ",
+			lines:        []taxedLine{{model.PartCategoryEngine, 1000, 0}},
+			shippingCost: 90_000,
+			wantLines:    []int64{0},
+			wantTaxes: []model.TaxLine{
+				{Category: model.PartCategoryEngine, TaxableMinor: 1000},
+				{Shipping: true, TaxableMinor: 90_000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote := &Quote{
+				Order: &model.Order{Shipment: model.Shipment{Country: tt.country, CostMinor: tt.shippingCost}},
+				Parts: make(map[string]*model.Part, len(tt.lines)),
+			}
+			for i, l := range tt.lines {
+				uuid := string(rune('a' + i))
+				quote.Order.Items = append(quote.Order.Items, model.OrderItem{PartUuid: uuid, Quantity: 1, LineTotalMinor: l.lineTotalMinor, DiscountMinor: l.discountMinor})
+				quote.Parts[uuid] = &model.Part{Uuid: uuid, Category: l.category}
+			}
+
+			if err := Tax(rules)(context.Background(), quote); err != nil {
+				t.Fatalf("tax: %v", err)
+			}
+
+			var gotLines []int64
+			for _, item := range quote.Order.Items {
+				gotLines = append(gotLines, item.TaxMinor)
+			}
+			if !slices.Equal(gotLines, tt.wantLines) {
+				t.Errorf("line taxes are %v, want %v", gotLines, tt.wantLines)
+			}
+			if !slices.Equal(quote.Order.Taxes, tt.wantTaxes) {
+				t.Errorf("taxes are %+v, want %+v", quote.Order.Taxes, tt.wantTaxes)
+			}
+			var total int64
+			for _, tax := range tt.wantTaxes {
+				total += tax.TaxMinor
+			}
+			if quote.Order.TaxMinor != total {
+				t.Errorf("order tax is %d, want %d", quote.Order.TaxMinor, total)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func ToModelIdempotencyRecord(record repomodel.IdempotencyRecord) *model.IdempotencyRecord {
+	return &model.IdempotencyRecord{
+		Key:         record.Key,
+		Fingerprint: record.Fingerprint,
+		Response:    ToModelIdempotentResponse(record.Response),
+		ExpiresAt:   record.ExpiresAt,
+	}
+}
+
+func ToModelIdempotentResponse(response *repomodel.IdempotentResponse) *model.IdempotentResponse {
+	if response == nil {
+		return nil
+	}
+	return &model.IdempotentResponse{
+		StatusCode:  response.StatusCode,
+		ContentType: response.ContentType,
+		ETag:        response.ETag,
+		Body:        slices.Clone(response.Body),
+	}
+}
+
+func ToRepoIdempotencyRecord(record model.IdempotencyRecord) repomodel.IdempotencyRecord {
+	return repomodel.IdempotencyRecord{
+		Key:         record.Key,
+		Fingerprint: record.Fingerprint,
+		Response:    ToRepoIdempotentResponse(record.Response),
+		ExpiresAt:   record.ExpiresAt,
+	}
+}
+
+func ToRepoIdempotentResponse(response *model.IdempotentResponse) *repomodel.IdempotentResponse {
+	if response == nil {
+		return nil
+	}
+	return &repomodel.IdempotentResponse{
+		StatusCode:  response.StatusCode,
+		ContentType: response.ContentType,
+		ETag:        response.ETag,
+		Body:        slices.Clone(response.Body),
+	}
+}
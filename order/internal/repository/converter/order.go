@@ -0,0 +1,358 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func ToModelOrder(order repomodel.Order) *model.Order {
+	return &model.Order{
+		OrderUuid:        order.OrderUuid,
+		Version:          order.Version,
+		UserUuid:         order.UserUuid,
+		Items:            ToModelOrderItems(order.Items),
+		SubtotalMinor:    order.SubtotalMinor,
+		PromoCode:        order.PromoCode,
+		DiscountMinor:    order.DiscountMinor,
+		Shipment:         ToModelShipment(order.Shipment),
+		Taxes:            ToModelTaxLines(order.Taxes),
+		TaxMinor:         order.TaxMinor,
+		TotalPriceMinor:  order.TotalPriceMinor,
+		TransactionUuid:  order.TransactionUuid,
+		PaymentMethod:    ToModelPaymentMethod(order.PaymentMethod),
+		Tenders:          ToModelTenders(order.Tenders),
+		Payments:         ToModelPayments(order.Payments),
+		PaidMinor:        order.PaidMinor,
+		PaymentAttempt:   order.PaymentAttempt,
+		PaymentStartedAt: cloneTime(order.PaymentStartedAt),
+		Status:           ToModelOrderStatus(order.Status),
+		CreatedAt:        order.CreatedAt,
+		PaymentDeadline:  order.PaymentDeadline,
+		PaidAt:           cloneTime(order.PaidAt),
+		CancelledAt:      cloneTime(order.CancelledAt),
+		History:          ToModelStatusTransitions(order.History),
+		RefundedMinor:    order.RefundedMinor,
+		Refunds:          ToModelRefunds(order.Refunds),
+	}
+}
+
+func ToModelOrderItems(items []repomodel.OrderItem) []model.OrderItem {
+	modelItems := make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		modelItems = append(modelItems, model.OrderItem{
+			PartUuid:         item.PartUuid,
+			PartName:         item.PartName,
+			Quantity:         item.Quantity,
+			UnitPriceMinor:   item.UnitPriceMinor,
+			LineTotalMinor:   item.LineTotalMinor,
+			DiscountMinor:    item.DiscountMinor,
+			TaxMinor:         item.TaxMinor,
+			RefundedQuantity: item.RefundedQuantity,
+		})
+	}
+	return modelItems
+}
+
+func ToModelStatusTransitions(transitions []repomodel.StatusTransition) []model.StatusTransition {
+	modelTransitions := make([]model.StatusTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		modelTransitions = append(modelTransitions, model.StatusTransition{
+			From:   ToModelOrderStatus(transition.From),
+			To:     ToModelOrderStatus(transition.To),
+			At:     transition.At,
+			Actor:  transition.Actor,
+			Reason: transition.Reason,
+		})
+	}
+	return modelTransitions
+}
+
+func ToModelShipment(shipment repomodel.Shipment) model.Shipment {
+	return model.Shipment{
+		Country:            shipment.Country,
+		ChargeableWeightKg: shipment.ChargeableWeightKg,
+		VolumeCm3:          shipment.VolumeCm3,
+		CostMinor:          shipment.CostMinor,
+	}
+}
+
+func ToModelTaxLines(taxes []repomodel.TaxLine) []model.TaxLine {
+	modelTaxes := make([]model.TaxLine, 0, len(taxes))
+	for _, tax := range taxes {
+		modelTaxes = append(modelTaxes, model.TaxLine{
+			Category:        ToModelPartCategory(tax.Category),
+			Shipping:        tax.Shipping,
+			RateBasisPoints: tax.RateBasisPoints,
+			TaxableMinor:    tax.TaxableMinor,
+			TaxMinor:        tax.TaxMinor,
+		})
+	}
+	return modelTaxes
+}
+
+func ToModelTenders(tenders []repomodel.Tender) []model.Tender {
+	modelTenders := make([]model.Tender, 0, len(tenders))
+	for _, tender := range tenders {
+		modelTenders = append(modelTenders, model.Tender{
+			PaymentMethod: ToModelPaymentMethod(tender.PaymentMethod),
+			AmountMinor:   tender.AmountMinor,
+		})
+	}
+	return modelTenders
+}
+
+func ToModelPayments(payments []repomodel.Payment) []model.Payment {
+	modelPayments := make([]model.Payment, 0, len(payments))
+	for _, payment := range payments {
+		modelPayments = append(modelPayments, model.Payment{
+			TransactionUuid: payment.TransactionUuid,
+			PaymentMethod:   ToModelPaymentMethod(payment.PaymentMethod),
+			AmountMinor:     payment.AmountMinor,
+			RefundedMinor:   payment.RefundedMinor,
+			PaidAt:          payment.PaidAt,
+		})
+	}
+	return modelPayments
+}
+
+func ToModelRefunds(refunds []repomodel.Refund) []model.Refund {
+	modelRefunds := make([]model.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		modelItems := make([]model.RefundItem, 0, len(refund.Items))
+		for _, item := range refund.Items {
+			modelItems = append(modelItems, model.RefundItem{
+				PartUuid: item.PartUuid,
+				Quantity: item.Quantity,
+			})
+		}
+		modelRefunds = append(modelRefunds, model.Refund{
+			Uuid:            refund.Uuid,
+			TransactionUuid: refund.TransactionUuid,
+			AmountMinor:     refund.AmountMinor,
+			Items:           modelItems,
+			Reason:          refund.Reason,
+			CreatedAt:       refund.CreatedAt,
+			Pending:         refund.Pending,
+		})
+	}
+	return modelRefunds
+}
+
+func ToModelOrderStatus(status repomodel.OrderStatus) model.OrderStatus {
+	switch status {
+	case repomodel.OrderStatusUnspecified:
+		return model.OrderStatusUnspecified
+	case repomodel.OrderStatusPendingPayment:
+		return model.OrderStatusPendingPayment
+	case repomodel.OrderStatusPartiallyPaid:
+		return model.OrderStatusPartiallyPaid
+	case repomodel.OrderStatusPaid:
+		return model.OrderStatusPaid
+	case repomodel.OrderStatusCancelled:
+		return model.OrderStatusCancelled
+	case repomodel.OrderStatusPartiallyRefunded:
+		return model.OrderStatusPartiallyRefunded
+	case repomodel.OrderStatusRefunded:
+		return model.OrderStatusRefunded
+	default:
+		return model.OrderStatusUnspecified
+	}
+}
+
+func ToModelPaymentMethod(method repomodel.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case repomodel.PaymentMethodUnspecified:
+		return model.PaymentMethodUnspecified
+	case repomodel.PaymentMethodCard:
+		return model.PaymentMethodCard
+	case repomodel.PaymentMethodSbp:
+		return model.PaymentMethodSbp
+	case repomodel.PaymentMethodCreditCard:
+		return model.PaymentMethodCreditCard
+	case repomodel.PaymentMethodInvestorMoney:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+func ToRepoOrder(order *model.Order) repomodel.Order {
+	return repomodel.Order{
+		OrderUuid:        order.OrderUuid,
+		Version:          order.Version,
+		UserUuid:         order.UserUuid,
+		Items:            ToRepoOrderItems(order.Items),
+		SubtotalMinor:    order.SubtotalMinor,
+		PromoCode:        order.PromoCode,
+		DiscountMinor:    order.DiscountMinor,
+		Shipment:         ToRepoShipment(order.Shipment),
+		Taxes:            ToRepoTaxLines(order.Taxes),
+		TaxMinor:         order.TaxMinor,
+		TotalPriceMinor:  order.TotalPriceMinor,
+		TransactionUuid:  order.TransactionUuid,
+		PaymentMethod:    ToRepoPaymentMethod(order.PaymentMethod),
+		Tenders:          ToRepoTenders(order.Tenders),
+		Payments:         ToRepoPayments(order.Payments),
+		PaidMinor:        order.PaidMinor,
+		PaymentAttempt:   order.PaymentAttempt,
+		PaymentStartedAt: cloneTime(order.PaymentStartedAt),
+		Status:           ToRepoOrderStatus(order.Status),
+		CreatedAt:        order.CreatedAt,
+		PaymentDeadline:  order.PaymentDeadline,
+		PaidAt:           cloneTime(order.PaidAt),
+		CancelledAt:      cloneTime(order.CancelledAt),
+		History:          ToRepoStatusTransitions(order.History),
+		RefundedMinor:    order.RefundedMinor,
+		Refunds:          ToRepoRefunds(order.Refunds),
+	}
+}
+
+func ToRepoOrderItems(items []model.OrderItem) []repomodel.OrderItem {
+	repoItems := make([]repomodel.OrderItem, 0, len(items))
+	for _, item := range items {
+		repoItems = append(repoItems, repomodel.OrderItem{
+			PartUuid:         item.PartUuid,
+			PartName:         item.PartName,
+			Quantity:         item.Quantity,
+			UnitPriceMinor:   item.UnitPriceMinor,
+			LineTotalMinor:   item.LineTotalMinor,
+			DiscountMinor:    item.DiscountMinor,
+			TaxMinor:         item.TaxMinor,
+			RefundedQuantity: item.RefundedQuantity,
+		})
+	}
+	return repoItems
+}
+
+func ToRepoStatusTransitions(transitions []model.StatusTransition) []repomodel.StatusTransition {
+	repoTransitions := make([]repomodel.StatusTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		repoTransitions = append(repoTransitions, repomodel.StatusTransition{
+			From:   ToRepoOrderStatus(transition.From),
+			To:     ToRepoOrderStatus(transition.To),
+			At:     transition.At,
+			Actor:  transition.Actor,
+			Reason: transition.Reason,
+		})
+	}
+	return repoTransitions
+}
+
+func ToRepoShipment(shipment model.Shipment) repomodel.Shipment {
+	return repomodel.Shipment{
+		Country:            shipment.Country,
+		ChargeableWeightKg: shipment.ChargeableWeightKg,
+		VolumeCm3:          shipment.VolumeCm3,
+		CostMinor:          shipment.CostMinor,
+	}
+}
+
+func ToRepoTaxLines(taxes []model.TaxLine) []repomodel.TaxLine {
+	repoTaxes := make([]repomodel.TaxLine, 0, len(taxes))
+	for _, tax := range taxes {
+		repoTaxes = append(repoTaxes, repomodel.TaxLine{
+			Category:        ToRepoPartCategory(tax.Category),
+			Shipping:        tax.Shipping,
+			RateBasisPoints: tax.RateBasisPoints,
+			TaxableMinor:    tax.TaxableMinor,
+			TaxMinor:        tax.TaxMinor,
+		})
+	}
+	return repoTaxes
+}
+
+func ToRepoTenders(tenders []model.Tender) []repomodel.Tender {
+	repoTenders := make([]repomodel.Tender, 0, len(tenders))
+	for _, tender := range tenders {
+		repoTenders = append(repoTenders, repomodel.Tender{
+			PaymentMethod: ToRepoPaymentMethod(tender.PaymentMethod),
+			AmountMinor:   tender.AmountMinor,
+		})
+	}
+	return repoTenders
+}
+
+func ToRepoPayments(payments []model.Payment) []repomodel.Payment {
+	repoPayments := make([]repomodel.Payment, 0, len(payments))
+	for _, payment := range payments {
+		repoPayments = append(repoPayments, repomodel.Payment{
+			TransactionUuid: payment.TransactionUuid,
+			PaymentMethod:   ToRepoPaymentMethod(payment.PaymentMethod),
+			AmountMinor:     payment.AmountMinor,
+			RefundedMinor:   payment.RefundedMinor,
+			PaidAt:          payment.PaidAt,
+		})
+	}
+	return repoPayments
+}
+
+func ToRepoRefunds(refunds []model.Refund) []repomodel.Refund {
+	repoRefunds := make([]repomodel.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		repoItems := make([]repomodel.RefundItem, 0, len(refund.Items))
+		for _, item := range refund.Items {
+			repoItems = append(repoItems, repomodel.RefundItem{
+				PartUuid: item.PartUuid,
+				Quantity: item.Quantity,
+			})
+		}
+		repoRefunds = append(repoRefunds, repomodel.Refund{
+			Uuid:            refund.Uuid,
+			TransactionUuid: refund.TransactionUuid,
+			AmountMinor:     refund.AmountMinor,
+			Items:           repoItems,
+			Reason:          refund.Reason,
+			CreatedAt:       refund.CreatedAt,
+			Pending:         refund.Pending,
+		})
+	}
+	return repoRefunds
+}
+
+func ToRepoOrderStatus(status model.OrderStatus) repomodel.OrderStatus {
+	switch status {
+	case model.OrderStatusUnspecified:
+		return repomodel.OrderStatusUnspecified
+	case model.OrderStatusPendingPayment:
+		return repomodel.OrderStatusPendingPayment
+	case model.OrderStatusPartiallyPaid:
+		return repomodel.OrderStatusPartiallyPaid
+	case model.OrderStatusPaid:
+		return repomodel.OrderStatusPaid
+	case model.OrderStatusCancelled:
+		return repomodel.OrderStatusCancelled
+	case model.OrderStatusPartiallyRefunded:
+		return repomodel.OrderStatusPartiallyRefunded
+	case model.OrderStatusRefunded:
+		return repomodel.OrderStatusRefunded
+	default:
+		return repomodel.OrderStatusUnspecified
+	}
+}
+
+func ToRepoPaymentMethod(method model.PaymentMethod) repomodel.PaymentMethod {
+	switch method {
+	case model.PaymentMethodUnspecified:
+		return repomodel.PaymentMethodUnspecified
+	case model.PaymentMethodCard:
+		return repomodel.PaymentMethodCard
+	case model.PaymentMethodSbp:
+		return repomodel.PaymentMethodSbp
+	case model.PaymentMethodCreditCard:
+		return repomodel.PaymentMethodCreditCard
+	case model.PaymentMethodInvestorMoney:
+		return repomodel.PaymentMethodInvestorMoney
+	default:
+		return repomodel.PaymentMethodUnspecified
+	}
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	clone := *t
+	return &clone
+}
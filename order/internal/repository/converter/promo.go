@@ -0,0 +1,103 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func ToModelPromoCode(promo repomodel.PromoCode) *model.PromoCode {
+	categories := make([]model.PartCategory, 0, len(promo.Categories))
+	for _, category := range promo.Categories {
+		categories = append(categories, ToModelPartCategory(category))
+	}
+
+	return &model.PromoCode{
+		Code:               promo.Code,
+		Kind:               ToModelPromoCodeKind(promo.Kind),
+		PercentOff:         promo.PercentOff,
+		AmountOffMinor:     promo.AmountOffMinor,
+		ValidFrom:          promo.ValidFrom,
+		ValidUntil:         cloneTime(promo.ValidUntil),
+		MaxUses:            promo.MaxUses,
+		MaxUsesPerUser:     promo.MaxUsesPerUser,
+		MinOrderTotalMinor: promo.MinOrderTotalMinor,
+		Categories:         categories,
+		UsedCount:          int64(len(promo.Redemptions)),
+		CreatedAt:          promo.CreatedAt,
+	}
+}
+
+func ToModelPromoCodeKind(kind repomodel.PromoCodeKind) model.PromoCodeKind {
+	switch kind {
+	case repomodel.PromoCodeKindPercentage:
+		return model.PromoCodeKindPercentage
+	case repomodel.PromoCodeKindFixedAmount:
+		return model.PromoCodeKindFixedAmount
+	default:
+		return model.PromoCodeKindUnspecified
+	}
+}
+
+func ToModelPartCategory(category repomodel.PartCategory) model.PartCategory {
+	switch category {
+	case repomodel.PartCategoryEngine:
+		return model.PartCategoryEngine
+	case repomodel.PartCategoryFuel:
+		return model.PartCategoryFuel
+	case repomodel.PartCategoryPorthole:
+		return model.PartCategoryPorthole
+	case repomodel.PartCategoryWing:
+		return model.PartCategoryWing
+	default:
+		return model.PartCategoryUnspecified
+	}
+}
+
+// ToRepoPromoCode converts the promo code without redemptions, they are only changed by the repository.
+func ToRepoPromoCode(promo *model.PromoCode) repomodel.PromoCode {
+	categories := make([]repomodel.PartCategory, 0, len(promo.Categories))
+	for _, category := range promo.Categories {
+		categories = append(categories, ToRepoPartCategory(category))
+	}
+
+	return repomodel.PromoCode{
+		Code:               promo.Code,
+		Kind:               ToRepoPromoCodeKind(promo.Kind),
+		PercentOff:         promo.PercentOff,
+		AmountOffMinor:     promo.AmountOffMinor,
+		ValidFrom:          promo.ValidFrom,
+		ValidUntil:         cloneTime(promo.ValidUntil),
+		MaxUses:            promo.MaxUses,
+		MaxUsesPerUser:     promo.MaxUsesPerUser,
+		MinOrderTotalMinor: promo.MinOrderTotalMinor,
+		Categories:         categories,
+		CreatedAt:          promo.CreatedAt,
+		Redemptions:        make(map[string]string),
+	}
+}
+
+func ToRepoPromoCodeKind(kind model.PromoCodeKind) repomodel.PromoCodeKind {
+	switch kind {
+	case model.PromoCodeKindPercentage:
+		return repomodel.PromoCodeKindPercentage
+	case model.PromoCodeKindFixedAmount:
+		return repomodel.PromoCodeKindFixedAmount
+	default:
+		return repomodel.PromoCodeKindUnspecified
+	}
+}
+
+func ToRepoPartCategory(category model.PartCategory) repomodel.PartCategory {
+	switch category {
+	case model.PartCategoryEngine:
+		return repomodel.PartCategoryEngine
+	case model.PartCategoryFuel:
+		return repomodel.PartCategoryFuel
+	case model.PartCategoryPorthole:
+		return repomodel.PartCategoryPorthole
+	case model.PartCategoryWing:
+		return repomodel.PartCategoryWing
+	default:
+		return repomodel.PartCategoryUnspecified
+	}
+}
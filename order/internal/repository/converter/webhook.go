@@ -0,0 +1,115 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func ToModelWebhook(webhook repomodel.Webhook) *model.Webhook {
+	eventTypes := make([]model.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, ToModelWebhookEventType(eventType))
+	}
+
+	return &model.Webhook{
+		Uuid:       webhook.Uuid,
+		Url:        webhook.Url,
+		EventTypes: eventTypes,
+		Secret:     webhook.Secret,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func ToModelWebhookEvent(event repomodel.WebhookEvent) *model.WebhookEvent {
+	return &model.WebhookEvent{
+		Uuid:      event.Uuid,
+		Type:      ToModelWebhookEventType(event.Type),
+		Order:     ToModelOrder(event.Order),
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func ToModelWebhookDelivery(delivery repomodel.WebhookDelivery) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		Uuid:          delivery.Uuid,
+		WebhookUuid:   delivery.WebhookUuid,
+		Event:         *ToModelWebhookEvent(delivery.Event),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		DeadAt:        cloneTime(delivery.DeadAt),
+	}
+}
+
+func ToModelWebhookEventType(eventType repomodel.WebhookEventType) model.WebhookEventType {
+	switch eventType {
+	case repomodel.WebhookEventTypeOrderCreated:
+		return model.WebhookEventTypeOrderCreated
+	case repomodel.WebhookEventTypeOrderUpdated:
+		return model.WebhookEventTypeOrderUpdated
+	case repomodel.WebhookEventTypeOrderPaid:
+		return model.WebhookEventTypeOrderPaid
+	case repomodel.WebhookEventTypeOrderCancelled:
+		return model.WebhookEventTypeOrderCancelled
+	case repomodel.WebhookEventTypeOrderRefunded:
+		return model.WebhookEventTypeOrderRefunded
+	default:
+		return model.WebhookEventTypeUnspecified
+	}
+}
+
+func ToRepoWebhook(webhook *model.Webhook) repomodel.Webhook {
+	eventTypes := make([]repomodel.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, ToRepoWebhookEventType(eventType))
+	}
+
+	return repomodel.Webhook{
+		Uuid:       webhook.Uuid,
+		Url:        webhook.Url,
+		EventTypes: eventTypes,
+		Secret:     webhook.Secret,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+func ToRepoWebhookEvent(event model.WebhookEvent) repomodel.WebhookEvent {
+	repoEvent := repomodel.WebhookEvent{
+		Uuid:      event.Uuid,
+		Type:      ToRepoWebhookEventType(event.Type),
+		CreatedAt: event.CreatedAt,
+	}
+	if event.Order != nil {
+		repoEvent.Order = ToRepoOrder(event.Order)
+	}
+	return repoEvent
+}
+
+func ToRepoWebhookDelivery(delivery *model.WebhookDelivery) repomodel.WebhookDelivery {
+	return repomodel.WebhookDelivery{
+		Uuid:          delivery.Uuid,
+		WebhookUuid:   delivery.WebhookUuid,
+		Event:         ToRepoWebhookEvent(delivery.Event),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		DeadAt:        cloneTime(delivery.DeadAt),
+	}
+}
+
+func ToRepoWebhookEventType(eventType model.WebhookEventType) repomodel.WebhookEventType {
+	switch eventType {
+	case model.WebhookEventTypeOrderCreated:
+		return repomodel.WebhookEventTypeOrderCreated
+	case model.WebhookEventTypeOrderUpdated:
+		return repomodel.WebhookEventTypeOrderUpdated
+	case model.WebhookEventTypeOrderPaid:
+		return repomodel.WebhookEventTypeOrderPaid
+	case model.WebhookEventTypeOrderCancelled:
+		return repomodel.WebhookEventTypeOrderCancelled
+	case model.WebhookEventTypeOrderRefunded:
+		return repomodel.WebhookEventTypeOrderRefunded
+	default:
+		return repomodel.WebhookEventTypeUnspecified
+	}
+}
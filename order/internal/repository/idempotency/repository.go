@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	def "github.com/qyrlabs/test-backend/order/internal/repository"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+var _ def.IdempotencyRepository = &repository{}
+
+type repository struct {
+	mu      sync.Mutex
+	records map[string]repomodel.IdempotencyRecord
+}
+
+func NewRepository() *repository {
+	return &repository{
+		records: make(map[string]repomodel.IdempotencyRecord),
+	}
+}
+
+func (r *repository) Reserve(ctx context.Context, record model.IdempotencyRecord) (*model.IdempotencyRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.records[record.Key]; ok && time.Now().Before(existing.ExpiresAt) {
+		return converter.ToModelIdempotencyRecord(existing), nil
+	}
+
+	r.records[record.Key] = converter.ToRepoIdempotencyRecord(record)
+	return nil, nil
+}
+
+func (r *repository) Complete(ctx context.Context, key string, response model.IdempotentResponse) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.records[key]
+	if !ok {
+		return nil
+	}
+	record.Response = converter.ToRepoIdempotentResponse(&response)
+	r.records[key] = record
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.records, key)
+	return nil
+}
+
+func (r *repository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for key, record := range r.records {
+		if !now.Before(record.ExpiresAt) {
+			delete(r.records, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,23 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+)
+
+func (r *repository) Create(ctx context.Context, order *model.Order) error {
+	order.Version = 1
+	repoOrder := converter.ToRepoOrder(order)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.orders[repoOrder.OrderUuid] = repoOrder
+	r.index(repoOrder)
+	r.insertByCreatedAt(repoOrder)
+	r.enqueue(order)
+
+	return nil
+}
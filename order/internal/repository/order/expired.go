@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func (r *repository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expired := make([]repomodel.Order, 0)
+	for orderUuid := range r.byStatus[repomodel.OrderStatusPendingPayment] {
+		order := r.orders[orderUuid]
+		if order.PaymentDeadline.Before(now) {
+			expired = append(expired, order)
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].PaymentDeadline.Before(expired[j].PaymentDeadline)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+
+	orders := make([]*model.Order, 0, len(expired))
+	for _, order := range expired {
+		orders = append(orders, converter.ToModelOrder(order))
+	}
+	return orders, nil
+}
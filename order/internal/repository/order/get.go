@@ -0,0 +1,20 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+)
+
+func (r *repository) Get(ctx context.Context, uuid string) (*model.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order, ok := r.orders[uuid]
+	if !ok {
+		return nil, model.ErrOrderNotFound
+	}
+
+	return converter.ToModelOrder(order), nil
+}
@@ -0,0 +1,55 @@
+package order
+
+import (
+	"slices"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+// index adds the order to secondary indexes. Must be called with mu held.
+func (r *repository) index(order repomodel.Order) {
+	addToSet(r.byUser, order.UserUuid, order.OrderUuid)
+	for _, item := range order.Items {
+		addToSet(r.byPart, item.PartUuid, order.OrderUuid)
+	}
+	addToSet(r.byStatus, order.Status, order.OrderUuid)
+}
+
+// unindex removes the order from secondary indexes. Must be called with mu held.
+func (r *repository) unindex(order repomodel.Order) {
+	removeFromSet(r.byUser, order.UserUuid, order.OrderUuid)
+	for _, item := range order.Items {
+		removeFromSet(r.byPart, item.PartUuid, order.OrderUuid)
+	}
+	removeFromSet(r.byStatus, order.Status, order.OrderUuid)
+}
+
+// insertByCreatedAt puts the order UUID into the creation time index.
+// Creation time never changes, so orders are inserted once. Must be called with mu held.
+func (r *repository) insertByCreatedAt(order repomodel.Order) {
+	i, _ := slices.BinarySearchFunc(r.byCreatedAt, keyOf(order), func(uuid string, target sortKey) int {
+		return keyOf(r.orders[uuid]).compare(target, model.OrdersSortByCreatedAt)
+	})
+	r.byCreatedAt = slices.Insert(r.byCreatedAt, i, order.OrderUuid)
+}
+
+func addToSet[K comparable](index map[K]map[string]struct{}, key K, uuid string) {
+	set, ok := index[key]
+	if !ok {
+		set = make(map[string]struct{})
+		index[key] = set
+	}
+	set[uuid] = struct{}{}
+}
+
+func removeFromSet[K comparable](index map[K]map[string]struct{}, key K, uuid string) {
+	set, ok := index[key]
+	if !ok {
+		return
+	}
+	delete(set, uuid)
+	if len(set) == 0 {
+		delete(index, key)
+	}
+}
@@ -0,0 +1,238 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+// sortKey is the position of an order in the List sort order.
+type sortKey struct {
+	createdAt       time.Time
+	totalPriceMinor int64
+	orderUuid       string
+}
+
+func keyOf(order repomodel.Order) sortKey {
+	return sortKey{
+		createdAt:       order.CreatedAt,
+		totalPriceMinor: order.TotalPriceMinor,
+		orderUuid:       order.OrderUuid,
+	}
+}
+
+func keyOfCursor(cursor model.OrdersCursor) sortKey {
+	return sortKey{
+		createdAt:       cursor.CreatedAt,
+		totalPriceMinor: cursor.TotalPriceMinor,
+		orderUuid:       cursor.OrderUuid,
+	}
+}
+
+// compare orders keys ascending by the sort field, ties are broken by UUID.
+func (k sortKey) compare(other sortKey, sortBy model.OrdersSortBy) int {
+	var c int
+	switch sortBy {
+	case model.OrdersSortByTotalPriceMinor:
+		c = cmp.Compare(k.totalPriceMinor, other.totalPriceMinor)
+	default:
+		c = k.createdAt.Compare(other.createdAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(k.orderUuid, other.orderUuid)
+}
+
+func (r *repository) List(ctx context.Context, query model.OrdersQuery) (*model.OrdersPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Sign of compare for orders that go later in the requested direction.
+	direction := 1
+	if query.Descending {
+		direction = -1
+	}
+
+	var after *sortKey
+	if query.Cursor != nil {
+		key := keyOfCursor(*query.Cursor)
+		after = &key
+	}
+
+	lo, hi := r.createdAtRange(query.Filter)
+	candidates, ok := r.smallestIndex(query.Filter, hi-lo)
+
+	var orders []repomodel.Order
+	switch {
+	case !ok && query.SortBy == model.OrdersSortByCreatedAt:
+		// The creation time index is already sorted, so the page is read
+		// without touching orders beyond it.
+		orders = r.scanByCreatedAt(query, lo, hi, after, direction)
+	default:
+		if !ok {
+			candidates = r.byCreatedAt[lo:hi]
+		}
+		for _, uuid := range candidates {
+			order := r.orders[uuid]
+			if !matches(order, query.Filter) {
+				continue
+			}
+			if after != nil && keyOf(order).compare(*after, query.SortBy)*direction <= 0 {
+				continue
+			}
+			orders = append(orders, order)
+		}
+		slices.SortFunc(orders, func(a, b repomodel.Order) int {
+			return keyOf(a).compare(keyOf(b), query.SortBy) * direction
+		})
+	}
+
+	page := &model.OrdersPage{}
+	if len(orders) > query.Limit {
+		orders = orders[:query.Limit]
+		last := orders[len(orders)-1]
+		page.NextCursor = &model.OrdersCursor{
+			SortBy:          query.SortBy,
+			Descending:      query.Descending,
+			CreatedAt:       last.CreatedAt,
+			TotalPriceMinor: last.TotalPriceMinor,
+			OrderUuid:       last.OrderUuid,
+		}
+	}
+
+	page.Orders = make([]*model.Order, 0, len(orders))
+	for _, order := range orders {
+		page.Orders = append(page.Orders, converter.ToModelOrder(order))
+	}
+
+	return page, nil
+}
+
+// createdAtRange returns bounds of byCreatedAt matching the creation period of the filter.
+func (r *repository) createdAtRange(filter model.OrdersFilter) (int, int) {
+	lo, hi := 0, len(r.byCreatedAt)
+	if filter.CreatedFrom != nil {
+		lo = sort.Search(len(r.byCreatedAt), func(i int) bool {
+			return !r.orders[r.byCreatedAt[i]].CreatedAt.Before(*filter.CreatedFrom)
+		})
+	}
+	if filter.CreatedTo != nil {
+		hi = sort.Search(len(r.byCreatedAt), func(i int) bool {
+			return !r.orders[r.byCreatedAt[i]].CreatedAt.Before(*filter.CreatedTo)
+		})
+	}
+	return lo, max(lo, hi)
+}
+
+// smallestIndex returns order UUIDs from the most selective index of the filter,
+// or false if none is smaller than rangeSize orders of the creation time index.
+func (r *repository) smallestIndex(filter model.OrdersFilter, rangeSize int) ([]string, bool) {
+	var best map[string]struct{}
+	found := false
+	consider := func(set map[string]struct{}) {
+		if len(set) < rangeSize && (!found || len(set) < len(best)) {
+			best, found = set, true
+		}
+	}
+
+	if filter.UserUuid != "" {
+		consider(r.byUser[filter.UserUuid])
+	}
+	if filter.PartUuid != "" {
+		consider(r.byPart[filter.PartUuid])
+	}
+	if len(filter.Statuses) > 0 {
+		// Statuses are disjoint, so their union is merged into one set only if it wins.
+		size := 0
+		for _, status := range uniqueStatuses(filter.Statuses) {
+			size += len(r.byStatus[converter.ToRepoOrderStatus(status)])
+		}
+		if size < rangeSize && (!found || size < len(best)) {
+			best = make(map[string]struct{}, size)
+			for _, status := range uniqueStatuses(filter.Statuses) {
+				for uuid := range r.byStatus[converter.ToRepoOrderStatus(status)] {
+					best[uuid] = struct{}{}
+				}
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	uuids := make([]string, 0, len(best))
+	for uuid := range best {
+		uuids = append(uuids, uuid)
+	}
+	return uuids, true
+}
+
+// scanByCreatedAt walks byCreatedAt[lo:hi] in the requested direction starting
+// after the cursor and collects up to Limit+1 matching orders.
+func (r *repository) scanByCreatedAt(query model.OrdersQuery, lo, hi int, after *sortKey, direction int) []repomodel.Order {
+	if after != nil {
+		if direction > 0 {
+			lo = max(lo, sort.Search(len(r.byCreatedAt), func(i int) bool {
+				return keyOf(r.orders[r.byCreatedAt[i]]).compare(*after, model.OrdersSortByCreatedAt) > 0
+			}))
+		} else {
+			hi = min(hi, sort.Search(len(r.byCreatedAt), func(i int) bool {
+				return keyOf(r.orders[r.byCreatedAt[i]]).compare(*after, model.OrdersSortByCreatedAt) >= 0
+			}))
+		}
+	}
+
+	orders := make([]repomodel.Order, 0, min(query.Limit+1, max(hi-lo, 0)))
+	for i := range max(hi-lo, 0) {
+		idx := lo + i
+		if direction < 0 {
+			idx = hi - 1 - i
+		}
+		order := r.orders[r.byCreatedAt[idx]]
+		if !matches(order, query.Filter) {
+			continue
+		}
+		orders = append(orders, order)
+		if len(orders) > query.Limit {
+			break
+		}
+	}
+	return orders
+}
+
+func matches(order repomodel.Order, filter model.OrdersFilter) bool {
+	if filter.UserUuid != "" && order.UserUuid != filter.UserUuid {
+		return false
+	}
+	if filter.PartUuid != "" && !slices.ContainsFunc(order.Items, func(item repomodel.OrderItem) bool {
+		return item.PartUuid == filter.PartUuid
+	}) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, converter.ToModelOrderStatus(order.Status)) {
+		return false
+	}
+	if filter.CreatedFrom != nil && order.CreatedAt.Before(*filter.CreatedFrom) {
+		return false
+	}
+	if filter.CreatedTo != nil && !order.CreatedAt.Before(*filter.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+func uniqueStatuses(statuses []model.OrderStatus) []model.OrderStatus {
+	unique := slices.Clone(statuses)
+	slices.Sort(unique)
+	return slices.Compact(unique)
+}
@@ -0,0 +1,253 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+var (
+	listBase  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	listUsers = []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	listParts = []string{uuid.NewString(), uuid.NewString()}
+)
+
+// listedOrders creates orders sharing five creation times and four totals,
+// so that most sort values are tied and ordered by UUID.
+func listedOrders(t *testing.T, r *repository, count int) []*model.Order {
+	t.Helper()
+
+	statuses := []model.OrderStatus{model.OrderStatusPendingPayment, model.OrderStatusPaid, model.OrderStatusCancelled}
+	orders := make([]*model.Order, 0, count)
+	for i := range count {
+		order := &model.Order{
+			OrderUuid:       uuid.NewString(),
+			UserUuid:        listUsers[i%len(listUsers)],
+			Items:           []model.OrderItem{{PartUuid: listParts[i%len(listParts)], Quantity: 1}},
+			TotalPriceMinor: int64(i%4) * 1000,
+			Status:          statuses[i%7%len(statuses)],
+			CreatedAt:       listBase.Add(time.Duration(i%5) * time.Minute),
+		}
+		if err := r.Create(context.Background(), order); err != nil {
+			t.Fatalf("create order: %v", err)
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// wantListed returns the UUIDs of orders matching keep in the sort order.
+func wantListed(orders []*model.Order, keep func(*model.Order) bool, sortBy model.OrdersSortBy, descending bool) []string {
+	var kept []*model.Order
+	for _, order := range orders {
+		if keep(order) {
+			kept = append(kept, order)
+		}
+	}
+	slices.SortFunc(kept, func(a, b *model.Order) int {
+		c := a.CreatedAt.Compare(b.CreatedAt)
+		if sortBy == model.OrdersSortByTotalPriceMinor {
+			c = cmp.Compare(a.TotalPriceMinor, b.TotalPriceMinor)
+		}
+		if c == 0 {
+			c = strings.Compare(a.OrderUuid, b.OrderUuid)
+		}
+		if descending {
+			return -c
+		}
+		return c
+	})
+
+	uuids := make([]string, 0, len(kept))
+	for _, order := range kept {
+		uuids = append(uuids, order.OrderUuid)
+	}
+	return uuids
+}
+
+// TestListWalksAllPages follows next cursors from the first page to the last
+// and expects every matching order exactly once, in the sort order.
+func TestListWalksAllPages(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	orders := listedOrders(t, r, 60)
+
+	lastMinute := listBase.Add(4 * time.Minute)
+	all := func(*model.Order) bool { return true }
+
+	tests := []struct {
+		name   string
+		filter model.OrdersFilter
+		keep   func(*model.Order) bool
+	}{
+		{
+			name: "all orders",
+			keep: all,
+		},
+		{
+			name:   "user index",
+			filter: model.OrdersFilter{UserUuid: listUsers[1]},
+			keep:   func(o *model.Order) bool { return o.UserUuid == listUsers[1] },
+		},
+		{
+			name:   "part index with status",
+			filter: model.OrdersFilter{PartUuid: listParts[0], Statuses: []model.OrderStatus{model.OrderStatusPaid}},
+			keep: func(o *model.Order) bool {
+				return o.Items[0].PartUuid == listParts[0] && o.Status == model.OrderStatusPaid
+			},
+		},
+		{
+			name:   "status index",
+			filter: model.OrdersFilter{Statuses: []model.OrderStatus{model.OrderStatusCancelled, model.OrderStatusPendingPayment}},
+			keep: func(o *model.Order) bool {
+				return o.Status == model.OrderStatusCancelled || o.Status == model.OrderStatusPendingPayment
+			},
+		},
+		{
+			name:   "creation period smaller than user index",
+			filter: model.OrdersFilter{UserUuid: listUsers[0], CreatedFrom: &lastMinute},
+			keep: func(o *model.Order) bool {
+				return o.UserUuid == listUsers[0] && !o.CreatedAt.Before(lastMinute)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, sortBy := range []model.OrdersSortBy{model.OrdersSortByCreatedAt, model.OrdersSortByTotalPriceMinor} {
+			for _, descending := range []bool{false, true} {
+				for _, limit := range []int{1, 4, 7, 100} {
+					want := wantListed(orders, tt.keep, sortBy, descending)
+					query := model.OrdersQuery{Filter: tt.filter, SortBy: sortBy, Descending: descending, Limit: limit}
+
+					var got []string
+					for pages := 0; ; pages++ {
+						if pages > len(orders) {
+							t.Fatalf("%s by %d, descending %v, limit %d: pages do not end", tt.name, sortBy, descending, limit)
+						}
+						page, err := r.List(ctx, query)
+						if err != nil {
+							t.Fatalf("list: %v", err)
+						}
+						if len(page.Orders) > limit {
+							t.Fatalf("%s: page of %d orders, limit %d", tt.name, len(page.Orders), limit)
+						}
+						for _, order := range page.Orders {
+							got = append(got, order.OrderUuid)
+						}
+						if page.NextCursor == nil {
+							break
+						}
+						query.Cursor = page.NextCursor
+					}
+
+					if !slices.Equal(got, want) {
+						t.Errorf("%s by %d, descending %v, limit %d: listed %d orders %v, want %d orders %v", tt.name, sortBy, descending, limit, len(got), got, len(want), want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestListLastPageHasNoCursor(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	listedOrders(t, r, 4)
+
+	page, err := r.List(ctx, model.OrdersQuery{Limit: 4})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(page.Orders) != 4 || page.NextCursor != nil {
+		t.Fatalf("listed %d orders, next cursor %+v, want 4 orders on the last page", len(page.Orders), page.NextCursor)
+	}
+
+	page, err = r.List(ctx, model.OrdersQuery{Limit: 3})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(page.Orders) != 3 || page.NextCursor == nil {
+		t.Fatalf("listed %d orders, next cursor %+v, want 3 orders and a cursor", len(page.Orders), page.NextCursor)
+	}
+}
+
+func TestSmallestIndex(t *testing.T) {
+	r := NewRepository()
+	orders := listedOrders(t, r, 60)
+
+	count := func(keep func(*model.Order) bool) int {
+		n := 0
+		for _, order := range orders {
+			if keep(order) {
+				n++
+			}
+		}
+		return n
+	}
+	byUser := count(func(o *model.Order) bool { return o.UserUuid == listUsers[0] })
+	paid := count(func(o *model.Order) bool { return o.Status == model.OrderStatusPaid })
+
+	tests := []struct {
+		name      string
+		filter    model.OrdersFilter
+		rangeSize int
+		// Size of the index chosen, -1 if the creation time index is scanned.
+		want int
+	}{
+		{
+			name:      "no filter",
+			rangeSize: len(orders),
+			want:      -1,
+		},
+		{
+			name:      "user",
+			filter:    model.OrdersFilter{UserUuid: listUsers[0]},
+			rangeSize: len(orders),
+			want:      byUser,
+		},
+		{
+			name:      "smaller of user and part",
+			filter:    model.OrdersFilter{UserUuid: listUsers[0], PartUuid: listParts[0]},
+			rangeSize: len(orders),
+			want:      byUser,
+		},
+		{
+			name:      "status smaller than user",
+			filter:    model.OrdersFilter{UserUuid: listUsers[0], Statuses: []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusPaid}},
+			rangeSize: len(orders),
+			want:      min(byUser, paid),
+		},
+		{
+			name:      "creation period smaller than indexes",
+			filter:    model.OrdersFilter{UserUuid: listUsers[0]},
+			rangeSize: byUser,
+			want:      -1,
+		},
+		{
+			name:      "unknown user",
+			filter:    model.OrdersFilter{UserUuid: uuid.NewString()},
+			rangeSize: len(orders),
+			want:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuids, ok := r.smallestIndex(tt.filter, tt.rangeSize)
+			got := len(uuids)
+			if !ok {
+				got = -1
+			}
+			if got != tt.want {
+				t.Errorf("index of %d orders chosen, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package order
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func (r *repository) ListEvents(ctx context.Context, limit int) ([]*model.WebhookEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := r.outbox[:min(limit, len(r.outbox))]
+	modelEvents := make([]*model.WebhookEvent, 0, len(events))
+	for _, event := range events {
+		modelEvents = append(modelEvents, converter.ToModelWebhookEvent(event))
+	}
+
+	return modelEvents, nil
+}
+
+func (r *repository) DeleteEvents(ctx context.Context, uuids []string) error {
+	deleted := make(map[string]struct{}, len(uuids))
+	for _, eventUuid := range uuids {
+		deleted[eventUuid] = struct{}{}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := make([]repomodel.WebhookEvent, 0, len(r.outbox))
+	for _, event := range r.outbox {
+		if _, ok := deleted[event.Uuid]; !ok {
+			kept = append(kept, event)
+		}
+	}
+	r.outbox = kept
+
+	return nil
+}
+
+// enqueue stores the events raised on the order in the outbox with the order
+// as stored. It must be called with mu held, after the order is stored.
+func (r *repository) enqueue(order *model.Order) {
+	for _, event := range order.Events {
+		r.outbox = append(r.outbox, repomodel.WebhookEvent{
+			Uuid:      uuid.NewString(),
+			Type:      converter.ToRepoWebhookEventType(event.Type),
+			Order:     r.orders[order.OrderUuid],
+			CreatedAt: event.CreatedAt,
+		})
+	}
+}
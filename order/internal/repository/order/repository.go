@@ -0,0 +1,39 @@
+package order
+
+import (
+	"sync"
+
+	def "github.com/qyrlabs/test-backend/order/internal/repository"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+var (
+	_ def.OrderRepository  = &repository{}
+	_ def.OutboxRepository = &repository{}
+)
+
+// repository keeps orders in memory together with secondary indexes used by
+// List and the outbox of webhook events raised by order changes.
+type repository struct {
+	mu     sync.RWMutex
+	orders map[string]repomodel.Order
+
+	// Order UUIDs by user, part and status.
+	byUser   map[string]map[string]struct{}
+	byPart   map[string]map[string]struct{}
+	byStatus map[repomodel.OrderStatus]map[string]struct{}
+	// Order UUIDs sorted by creation time, then by UUID.
+	byCreatedAt []string
+
+	// Webhook events in the order they were stored.
+	outbox []repomodel.WebhookEvent
+}
+
+func NewRepository() *repository {
+	return &repository{
+		orders:   make(map[string]repomodel.Order),
+		byUser:   make(map[string]map[string]struct{}),
+		byPart:   make(map[string]map[string]struct{}),
+		byStatus: make(map[repomodel.OrderStatus]map[string]struct{}),
+	}
+}
@@ -0,0 +1,40 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func (r *repository) Update(ctx context.Context, order *model.Order) error {
+	repoOrder := converter.ToRepoOrder(order)
+	repoOrder.Version++
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, ok := r.orders[repoOrder.OrderUuid]
+	if !ok {
+		return model.ErrOrderNotFound
+	}
+	if old.Version != order.Version {
+		return model.ErrOrderChanged
+	}
+	r.replace(old, repoOrder)
+	r.enqueue(order)
+
+	order.Version = repoOrder.Version
+	return nil
+}
+
+// replace swaps the stored order and its index entries. It must be called with mu held.
+func (r *repository) replace(old, repoOrder repomodel.Order) {
+	// Creation time is immutable and keeps the order position in byCreatedAt.
+	repoOrder.CreatedAt = old.CreatedAt
+
+	r.unindex(old)
+	r.orders[repoOrder.OrderUuid] = repoOrder
+	r.index(repoOrder)
+}
@@ -0,0 +1,120 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	def "github.com/qyrlabs/test-backend/order/internal/repository"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+var _ def.PromoCodeRepository = &repository{}
+
+type repository struct {
+	mu sync.Mutex
+	// Promo codes by code.
+	promoCodes map[string]repomodel.PromoCode
+}
+
+func NewRepository() *repository {
+	return &repository{
+		promoCodes: make(map[string]repomodel.PromoCode),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, promo *model.PromoCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.promoCodes[promo.Code]; ok {
+		return model.ErrPromoCodeExists
+	}
+
+	r.promoCodes[promo.Code] = converter.ToRepoPromoCode(promo)
+	return nil
+}
+
+func (r *repository) Get(ctx context.Context, code string) (*model.PromoCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promo, ok := r.promoCodes[code]
+	if !ok {
+		return nil, model.ErrPromoCodeNotFound
+	}
+
+	return converter.ToModelPromoCode(promo), nil
+}
+
+func (r *repository) List(ctx context.Context) ([]*model.PromoCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promoCodes := make([]*model.PromoCode, 0, len(r.promoCodes))
+	for _, promo := range r.promoCodes {
+		promoCodes = append(promoCodes, converter.ToModelPromoCode(promo))
+	}
+
+	sort.Slice(promoCodes, func(i, j int) bool {
+		if !promoCodes[i].CreatedAt.Equal(promoCodes[j].CreatedAt) {
+			return promoCodes[i].CreatedAt.Before(promoCodes[j].CreatedAt)
+		}
+		return promoCodes[i].Code < promoCodes[j].Code
+	})
+	return promoCodes, nil
+}
+
+func (r *repository) Delete(ctx context.Context, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.promoCodes[code]; !ok {
+		return model.ErrPromoCodeNotFound
+	}
+
+	delete(r.promoCodes, code)
+	return nil
+}
+
+func (r *repository) Redeem(ctx context.Context, code, userUuid, orderUuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promo, ok := r.promoCodes[code]
+	if !ok {
+		return model.ErrPromoCodeNotFound
+	}
+
+	if promo.MaxUses > 0 && int64(len(promo.Redemptions)) >= promo.MaxUses {
+		return fmt.Errorf("%w: usage limit reached", model.ErrPromoCodeNotApplicable)
+	}
+	if promo.MaxUsesPerUser > 0 {
+		var used int64
+		for _, user := range promo.Redemptions {
+			if user == userUuid {
+				used++
+			}
+		}
+		if used >= promo.MaxUsesPerUser {
+			return fmt.Errorf("%w: usage limit per user reached", model.ErrPromoCodeNotApplicable)
+		}
+	}
+
+	promo.Redemptions[orderUuid] = userUuid
+	return nil
+}
+
+func (r *repository) Release(ctx context.Context, code, orderUuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A deleted code has nothing to release.
+	if promo, ok := r.promoCodes[code]; ok {
+		delete(promo.Redemptions, orderUuid)
+	}
+	return nil
+}
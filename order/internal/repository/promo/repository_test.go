@@ -0,0 +1,122 @@
+package promo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func TestRedeem(t *testing.T) {
+	// redemption of the code by a user for an order.
+	type redemption struct {
+		user, order string
+	}
+
+	tests := []struct {
+		name           string
+		maxUses        int64
+		maxUsesPerUser int64
+		// Orders released after being redeemed.
+		released    []string
+		redemptions []redemption
+		// Error of each redemption, nil if it succeeds.
+		want []error
+	}{
+		{
+			name:        "unlimited",
+			redemptions: []redemption{{"ann", "1"}, {"ann", "2"}, {"bob", "3"}},
+			want:        []error{nil, nil, nil},
+		},
+		{
+			name:        "global limit",
+			maxUses:     2,
+			redemptions: []redemption{{"ann", "1"}, {"bob", "2"}, {"eve", "3"}},
+			want:        []error{nil, nil, model.ErrPromoCodeNotApplicable},
+		},
+		{
+			name:           "per user limit",
+			maxUsesPerUser: 1,
+			redemptions:    []redemption{{"ann", "1"}, {"bob", "2"}, {"ann", "3"}},
+			want:           []error{nil, nil, model.ErrPromoCodeNotApplicable},
+		},
+		{
+			name:           "global limit reached before per user limit",
+			maxUses:        2,
+			maxUsesPerUser: 2,
+			redemptions:    []redemption{{"ann", "1"}, {"bob", "2"}, {"bob", "3"}},
+			want:           []error{nil, nil, model.ErrPromoCodeNotApplicable},
+		},
+		{
+			name:        "released order frees a use",
+			maxUses:     1,
+			released:    []string{"1"},
+			redemptions: []redemption{{"ann", "1"}, {"bob", "2"}},
+			want:        []error{nil, nil},
+		},
+		{
+			name:           "released order frees a use of the user",
+			maxUsesPerUser: 1,
+			released:       []string{"1"},
+			redemptions:    []redemption{{"ann", "1"}, {"ann", "2"}},
+			want:           []error{nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := NewRepository()
+			promo := &model.PromoCode{Code: "SALE", Kind: model.PromoCodeKindPercentage, PercentOff: 10, MaxUses: tt.maxUses, MaxUsesPerUser: tt.maxUsesPerUser}
+			if err := r.Create(ctx, promo); err != nil {
+				t.Fatalf("create promo code: %v", err)
+			}
+
+			released := make(map[string]bool, len(tt.released))
+			for _, order := range tt.released {
+				released[order] = true
+			}
+			for i, redemption := range tt.redemptions {
+				err := r.Redeem(ctx, promo.Code, redemption.user, redemption.order)
+				if !errors.Is(err, tt.want[i]) {
+					t.Fatalf("redemption %d: got %v, want %v", i, err, tt.want[i])
+				}
+				if err == nil && released[redemption.order] {
+					if err := r.Release(ctx, promo.Code, redemption.order); err != nil {
+						t.Fatalf("release order %s: %v", redemption.order, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRedeemCountsUses(t *testing.T) {
+	ctx := context.Background()
+	r := NewRepository()
+	if err := r.Create(ctx, &model.PromoCode{Code: "SALE", MaxUses: 2}); err != nil {
+		t.Fatalf("create promo code: %v", err)
+	}
+
+	if err := r.Redeem(ctx, "SALE", "ann", "1"); err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+	if err := r.Redeem(ctx, "SALE", "ann", "2"); err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+	if err := r.Release(ctx, "SALE", "1"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+
+	promo, err := r.Get(ctx, "SALE")
+	if err != nil {
+		t.Fatalf("get promo code: %v", err)
+	}
+	if promo.UsedCount != 1 {
+		t.Errorf("used count is %d, want 1", promo.UsedCount)
+	}
+	if err := r.Redeem(ctx, "MISSING", "ann", "3"); !errors.Is(err, model.ErrPromoCodeNotFound) {
+		t.Errorf("redeem missing code: got %v, want %v", err, model.ErrPromoCodeNotFound)
+	}
+}
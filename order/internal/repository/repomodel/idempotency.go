@@ -0,0 +1,17 @@
+package repomodel
+
+import "time"
+
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	Response    *IdempotentResponse
+	ExpiresAt   time.Time
+}
+
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	ETag        string
+	Body        []byte
+}
@@ -0,0 +1,152 @@
+package repomodel
+
+import "time"
+
+type Order struct {
+	// Unique identifier of the order.
+	OrderUuid string
+	// Version of the order, incremented by every update.
+	Version int64
+	// UUID of the user who placed the order.
+	UserUuid string
+	// Ordered line items.
+	Items []OrderItem
+	// Sum of line totals in minor units.
+	SubtotalMinor int64
+	// Applied promo code, empty if none.
+	PromoCode string
+	// Sum of line discounts in minor units.
+	DiscountMinor int64
+	// Shipping of the order.
+	Shipment Shipment
+	// Taxes by rate.
+	Taxes []TaxLine
+	// Sum of line and shipping taxes in minor units.
+	TaxMinor int64
+	// Order total in minor units.
+	TotalPriceMinor int64
+	// UUID of the transaction of the first payment, empty until paid.
+	TransactionUuid string
+	// Payment method of the first payment.
+	PaymentMethod PaymentMethod
+	// Tenders the order is being paid or was paid with.
+	Tenders []Tender
+	// Payments of the tenders in the order they were charged.
+	Payments []Payment
+	// Sum of payments in minor units.
+	PaidMinor int64
+	// Number of the current attempt to pay the order.
+	PaymentAttempt int64
+	// Start of the payment attempt in progress, nil if none.
+	PaymentStartedAt *time.Time
+	// Order status.
+	Status OrderStatus
+	// Creation timestamp.
+	CreatedAt time.Time
+	// Time after which an unpaid order can no longer be paid and expires.
+	PaymentDeadline time.Time
+	// Payment timestamp, nil until paid.
+	PaidAt *time.Time
+	// Cancellation timestamp, nil unless cancelled.
+	CancelledAt *time.Time
+	// Status transitions in chronological order.
+	History []StatusTransition
+	// Sum of refunded amounts in minor units.
+	RefundedMinor int64
+	// Refunds in chronological order.
+	Refunds []Refund
+}
+
+// Line item of the Order.
+type OrderItem struct {
+	PartUuid         string
+	PartName         string
+	Quantity         int64
+	UnitPriceMinor   int64
+	LineTotalMinor   int64
+	DiscountMinor    int64
+	TaxMinor         int64
+	RefundedQuantity int64
+}
+
+// Part of the Order total paid with one payment method.
+type Tender struct {
+	PaymentMethod PaymentMethod
+	AmountMinor   int64
+}
+
+// Charged Tender of the Order.
+type Payment struct {
+	TransactionUuid string
+	PaymentMethod   PaymentMethod
+	AmountMinor     int64
+	RefundedMinor   int64
+	PaidAt          time.Time
+}
+
+// Status of the Order.
+type OrderStatus int32
+
+const (
+	OrderStatusUnspecified       OrderStatus = 0
+	OrderStatusPendingPayment    OrderStatus = 1
+	OrderStatusPaid              OrderStatus = 2
+	OrderStatusCancelled         OrderStatus = 3
+	OrderStatusPartiallyRefunded OrderStatus = 4
+	OrderStatusRefunded          OrderStatus = 5
+	OrderStatusPartiallyPaid     OrderStatus = 6
+)
+
+// Method used to pay the Order.
+type PaymentMethod int32
+
+const (
+	PaymentMethodUnspecified   PaymentMethod = 0
+	PaymentMethodCard          PaymentMethod = 1
+	PaymentMethodSbp           PaymentMethod = 2
+	PaymentMethodCreditCard    PaymentMethod = 3
+	PaymentMethodInvestorMoney PaymentMethod = 4
+)
+
+// StatusTransition is a change of the Order status.
+type StatusTransition struct {
+	From   OrderStatus
+	To     OrderStatus
+	At     time.Time
+	Actor  string
+	Reason string
+}
+
+// Refund of the Order.
+type Refund struct {
+	Uuid            string
+	TransactionUuid string
+	AmountMinor     int64
+	Items           []RefundItem
+	Reason          string
+	CreatedAt       time.Time
+	Pending         bool
+}
+
+// Line item returned to inventory by the Refund.
+type RefundItem struct {
+	PartUuid string
+	Quantity int64
+}
+
+// Shipment is the shipping of an Order.
+type Shipment struct {
+	Country            string
+	ChargeableWeightKg float64
+	VolumeCm3          float64
+	CostMinor          int64
+}
+
+// TaxLine sums taxes of an Order charged at the same rate.
+type TaxLine struct {
+	Category        PartCategory
+	Shipping        bool
+	RateBasisPoints int64
+	TaxableMinor    int64
+	TaxMinor        int64
+}
@@ -0,0 +1,39 @@
+package repomodel
+
+import "time"
+
+type PromoCode struct {
+	Code               string
+	Kind               PromoCodeKind
+	PercentOff         int64
+	AmountOffMinor     int64
+	ValidFrom          time.Time
+	ValidUntil         *time.Time
+	MaxUses            int64
+	MaxUsesPerUser     int64
+	MinOrderTotalMinor int64
+	Categories         []PartCategory
+	CreatedAt          time.Time
+	// User UUIDs by UUIDs of orders using the code.
+	Redemptions map[string]string
+}
+
+// Kind of the PromoCode discount.
+type PromoCodeKind int32
+
+const (
+	PromoCodeKindUnspecified PromoCodeKind = 0
+	PromoCodeKindPercentage  PromoCodeKind = 1
+	PromoCodeKindFixedAmount PromoCodeKind = 2
+)
+
+// Category of a part.
+type PartCategory int32
+
+const (
+	PartCategoryUnspecified PartCategory = 0
+	PartCategoryEngine      PartCategory = 1
+	PartCategoryFuel        PartCategory = 2
+	PartCategoryPorthole    PartCategory = 3
+	PartCategoryWing        PartCategory = 4
+)
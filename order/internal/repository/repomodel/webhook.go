@@ -0,0 +1,41 @@
+package repomodel
+
+import "time"
+
+type Webhook struct {
+	Uuid       string
+	Url        string
+	EventTypes []WebhookEventType
+	Secret     string
+	CreatedAt  time.Time
+}
+
+// WebhookEvent is an outbox entry holding the order as stored by the change.
+type WebhookEvent struct {
+	Uuid      string
+	Type      WebhookEventType
+	Order     Order
+	CreatedAt time.Time
+}
+
+// Type of the WebhookEvent.
+type WebhookEventType int32
+
+const (
+	WebhookEventTypeUnspecified    WebhookEventType = 0
+	WebhookEventTypeOrderCreated   WebhookEventType = 1
+	WebhookEventTypeOrderUpdated   WebhookEventType = 2
+	WebhookEventTypeOrderPaid      WebhookEventType = 3
+	WebhookEventTypeOrderCancelled WebhookEventType = 4
+	WebhookEventTypeOrderRefunded  WebhookEventType = 5
+)
+
+type WebhookDelivery struct {
+	Uuid          string
+	WebhookUuid   string
+	Event         WebhookEvent
+	Attempts      int64
+	NextAttemptAt time.Time
+	LastError     string
+	DeadAt        *time.Time
+}
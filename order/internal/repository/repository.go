@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// OrderRepository stores orders. Create and Update store the events raised on
+// the order in the outbox atomically with the order.
+type OrderRepository interface {
+	Get(ctx context.Context, uuid string) (*model.Order, error)
+	List(ctx context.Context, query model.OrdersQuery) (*model.OrdersPage, error)
+	// Create stores a new order at version 1.
+	Create(ctx context.Context, order *model.Order) error
+	// Update replaces the stored order if it is still at order.Version,
+	// otherwise it returns ErrOrderChanged. On success order.Version is
+	// advanced to the stored version.
+	Update(ctx context.Context, order *model.Order) error
+	// ListExpired returns up to limit orders pending payment with the payment
+	// deadline before now, earliest deadline first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*model.Order, error)
+}
+
+// OutboxRepository reads the webhook events stored by OrderRepository.
+type OutboxRepository interface {
+	// ListEvents returns up to limit events, oldest first.
+	ListEvents(ctx context.Context, limit int) ([]*model.WebhookEvent, error)
+	// DeleteEvents removes dispatched events.
+	DeleteEvents(ctx context.Context, uuids []string) error
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) error
+	Get(ctx context.Context, uuid string) (*model.Webhook, error)
+	// List returns all webhooks in creation order.
+	List(ctx context.Context) ([]*model.Webhook, error)
+	// Delete removes the webhook with its pending deliveries, dead letters are kept.
+	Delete(ctx context.Context, uuid string) error
+	AddDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	// ListDueDeliveries returns up to limit pending deliveries with the next
+	// attempt not after now, earliest first.
+	ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error)
+	// UpdateDelivery stores the outcome of a failed attempt.
+	UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	DeleteDelivery(ctx context.Context, uuid string) error
+	// ListDeadLetters returns dead-lettered deliveries, most recent first.
+	ListDeadLetters(ctx context.Context) ([]*model.WebhookDelivery, error)
+}
+
+type PromoCodeRepository interface {
+	Create(ctx context.Context, promo *model.PromoCode) error
+	Get(ctx context.Context, code string) (*model.PromoCode, error)
+	// List returns all promo codes in creation order.
+	List(ctx context.Context) ([]*model.PromoCode, error)
+	Delete(ctx context.Context, code string) error
+	// Redeem records the use of the code by the order, or returns
+	// ErrPromoCodeNotApplicable if a usage limit has been reached.
+	Redeem(ctx context.Context, code, userUuid, orderUuid string) error
+	// Release removes the use of the code by the order.
+	Release(ctx context.Context, code, orderUuid string) error
+}
+
+type IdempotencyRepository interface {
+	// Reserve stores the record unless an unexpired record with the same key
+	// exists, in which case the existing record is returned.
+	Reserve(ctx context.Context, record model.IdempotencyRecord) (*model.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, response model.IdempotentResponse) error
+	Delete(ctx context.Context, key string) error
+	// DeleteExpired removes records expired by now and returns their number.
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
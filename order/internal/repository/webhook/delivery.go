@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+func (r *repository) AddDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		r.deliveries[delivery.Uuid] = converter.ToRepoWebhookDelivery(delivery)
+	}
+	return nil
+}
+
+func (r *repository) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := make([]repomodel.WebhookDelivery, 0)
+	for _, delivery := range r.deliveries {
+		if delivery.DeadAt == nil && !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].NextAttemptAt.Equal(due[j].NextAttemptAt) {
+			return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+		}
+		return due[i].Event.CreatedAt.Before(due[j].Event.CreatedAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(due))
+	for _, delivery := range due {
+		deliveries = append(deliveries, converter.ToModelWebhookDelivery(delivery))
+	}
+	return deliveries, nil
+}
+
+func (r *repository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The delivery is gone if its webhook was deleted during the attempt.
+	if _, ok := r.deliveries[delivery.Uuid]; ok {
+		r.deliveries[delivery.Uuid] = converter.ToRepoWebhookDelivery(delivery)
+	}
+	return nil
+}
+
+func (r *repository) DeleteDelivery(ctx context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.deliveries, uuid)
+	return nil
+}
+
+func (r *repository) ListDeadLetters(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dead := make([]*model.WebhookDelivery, 0)
+	for _, delivery := range r.deliveries {
+		if delivery.DeadAt != nil {
+			dead = append(dead, converter.ToModelWebhookDelivery(delivery))
+		}
+	}
+
+	sort.Slice(dead, func(i, j int) bool {
+		if !dead[i].DeadAt.Equal(*dead[j].DeadAt) {
+			return dead[i].DeadAt.After(*dead[j].DeadAt)
+		}
+		return dead[i].Uuid < dead[j].Uuid
+	})
+	return dead, nil
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	def "github.com/qyrlabs/test-backend/order/internal/repository"
+	"github.com/qyrlabs/test-backend/order/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/order/internal/repository/repomodel"
+)
+
+var _ def.WebhookRepository = &repository{}
+
+type repository struct {
+	mu sync.Mutex
+	// Webhooks by UUID.
+	webhooks map[string]repomodel.Webhook
+	// Pending and dead-lettered deliveries by UUID.
+	deliveries map[string]repomodel.WebhookDelivery
+}
+
+func NewRepository() *repository {
+	return &repository{
+		webhooks:   make(map[string]repomodel.Webhook),
+		deliveries: make(map[string]repomodel.WebhookDelivery),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, webhook *model.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.webhooks[webhook.Uuid] = converter.ToRepoWebhook(webhook)
+	return nil
+}
+
+func (r *repository) Get(ctx context.Context, uuid string) (*model.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook, ok := r.webhooks[uuid]
+	if !ok {
+		return nil, model.ErrWebhookNotFound
+	}
+
+	return converter.ToModelWebhook(webhook), nil
+}
+
+func (r *repository) List(ctx context.Context) ([]*model.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhooks := make([]*model.Webhook, 0, len(r.webhooks))
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, converter.ToModelWebhook(webhook))
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		if !webhooks[i].CreatedAt.Equal(webhooks[j].CreatedAt) {
+			return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+		}
+		return webhooks[i].Uuid < webhooks[j].Uuid
+	})
+	return webhooks, nil
+}
+
+func (r *repository) Delete(ctx context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[uuid]; !ok {
+		return model.ErrWebhookNotFound
+	}
+
+	delete(r.webhooks, uuid)
+	for deliveryUuid, delivery := range r.deliveries {
+		if delivery.WebhookUuid == uuid && delivery.DeadAt == nil {
+			delete(r.deliveries, deliveryUuid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+var _ def.IdempotencyService = &service{}
+
+type service struct {
+	idempotencyRepository repository.IdempotencyRepository
+	// How long keys are kept after the first request.
+	retention time.Duration
+}
+
+func NewService(idempotencyRepository repository.IdempotencyRepository, retention time.Duration) *service {
+	return &service{
+		idempotencyRepository: idempotencyRepository,
+		retention:             retention,
+	}
+}
+
+func (s *service) Begin(ctx context.Context, key, fingerprint string) (*model.IdempotentResponse, error) {
+	existing, err := s.idempotencyRepository.Reserve(ctx, model.IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   time.Now().Add(s.retention),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, nil
+	}
+
+	if existing.Fingerprint != fingerprint {
+		return nil, model.ErrIdempotencyKeyReused
+	}
+	if existing.Response == nil {
+		return nil, model.ErrIdempotencyKeyInUse
+	}
+	return existing.Response, nil
+}
+
+func (s *service) Complete(ctx context.Context, key string, response model.IdempotentResponse) error {
+	return s.idempotencyRepository.Complete(ctx, key, response)
+}
+
+func (s *service) Abort(ctx context.Context, key string) error {
+	return s.idempotencyRepository.Delete(ctx, key)
+}
+
+func (s *service) Cleanup(ctx context.Context) (int, error) {
+	return s.idempotencyRepository.DeleteExpired(ctx, time.Now())
+}
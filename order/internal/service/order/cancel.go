@@ -0,0 +1,31 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func (s *service) Cancel(ctx context.Context, uuid string, ifMatch []int64) (*model.Order, error) {
+	order, err := s.orderRepository.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(order, ifMatch); err != nil {
+		return nil, err
+	}
+
+	seen := len(order.History)
+	err = s.machine.Fire(ctx, order, model.OrderEventCancel, model.UserActor(order.UserUuid), "cancelled by user")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		return nil, err
+	}
+	s.releasePromoCode(ctx, order)
+	s.publish(order, seen)
+
+	return order, nil
+}
@@ -0,0 +1,150 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/pricing"
+)
+
+func (s *service) Create(ctx context.Context, req model.OrderRequest) (*model.Order, error) {
+	now := time.Now()
+	order := &model.Order{
+		OrderUuid:       uuid.NewString(),
+		UserUuid:        req.UserUuid,
+		Shipment:        model.Shipment{Country: req.ShippingCountry},
+		CreatedAt:       now,
+		PaymentDeadline: now.Add(s.settings.Load().PaymentDeadline),
+	}
+	if order.Shipment.Country == "" {
+		order.Shipment.Country = s.defaultCountry
+	}
+	s.machine.Start(order, now, model.UserActor(req.UserUuid), "order created")
+
+	if err := s.price(ctx, order, mergeItems(req.Items), req.PromoCode, now); err != nil {
+		return nil, err
+	}
+	order.Raise(model.WebhookEventTypeOrderCreated, now)
+
+	if order.PromoCode == "" {
+		if err := s.orderRepository.Create(ctx, order); err != nil {
+			return nil, err
+		}
+		s.publish(order, 0)
+		return order, nil
+	}
+
+	// Redeemed before the order is stored so that usage limits hold under concurrent orders.
+	if err := s.promoCodeRepository.Redeem(ctx, order.PromoCode, order.UserUuid, order.OrderUuid); err != nil {
+		return nil, err
+	}
+	if err := s.orderRepository.Create(ctx, order); err != nil {
+		if rerr := s.promoCodeRepository.Release(ctx, order.PromoCode, order.OrderUuid); rerr != nil {
+			log.Printf("failed to release promo code %s of order %s: %v", order.PromoCode, order.OrderUuid, rerr)
+		}
+		return nil, err
+	}
+	s.publish(order, 0)
+
+	return order, nil
+}
+
+// price replaces the order lines with items at current inventory prices,
+// checking that the parts exist and are in stock, and prices the order with
+// the promo code valid at the given time.
+func (s *service) price(ctx context.Context, order *model.Order, items []model.OrderItemRequest, promoCode string, at time.Time) error {
+	partsByUuid, err := s.partsOf(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	order.Items = make([]model.OrderItem, 0, len(items))
+	for _, item := range items {
+		part := partsByUuid[item.PartUuid]
+		order.Items = append(order.Items, model.OrderItem{
+			PartUuid:       part.Uuid,
+			PartName:       part.Name,
+			Quantity:       item.Quantity,
+			UnitPriceMinor: part.PriceMinor,
+		})
+	}
+
+	quote := &pricing.Quote{
+		Order: order,
+		Parts: partsByUuid,
+		At:    at,
+	}
+	if promoCode != "" {
+		quote.PromoCode, err = s.promoCodeRepository.Get(ctx, strings.ToUpper(promoCode))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.pricing.Price(ctx, quote)
+}
+
+// partsOf returns the parts of the items by UUID, checking that they exist
+// and are in stock. Cached parts are trusted for prices, but a part missing or
+// out of stock in the cache is confirmed against inventory.
+func (s *service) partsOf(ctx context.Context, items []model.OrderItemRequest) (map[string]*model.Part, error) {
+	filter := model.PartsFilter{Uuids: make([]string, 0, len(items))}
+	for _, item := range items {
+		filter.Uuids = append(filter.Uuids, item.PartUuid)
+	}
+
+	for {
+		parts, err := s.inventoryClient.ListParts(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to get filtered parts: %w", model.ErrUpstream, err)
+		}
+
+		partsByUuid := make(map[string]*model.Part, len(parts))
+		for _, part := range parts {
+			partsByUuid[part.Uuid] = part
+		}
+
+		err = checkStock(items, partsByUuid)
+		if err == nil {
+			return partsByUuid, nil
+		}
+		if filter.SkipCache {
+			return nil, err
+		}
+		filter.SkipCache = true
+	}
+}
+
+func checkStock(items []model.OrderItemRequest, partsByUuid map[string]*model.Part) error {
+	for _, item := range items {
+		part, ok := partsByUuid[item.PartUuid]
+		if !ok {
+			return model.ErrPartsNotFound
+		}
+		if item.Quantity > part.StockQuantity {
+			return fmt.Errorf("%w: part %s has %d in stock, %d requested", model.ErrInsufficientStock, part.Uuid, part.StockQuantity, item.Quantity)
+		}
+	}
+	return nil
+}
+
+// mergeItems sums quantities of items with the same part, keeping the order of first occurrence.
+func mergeItems(items []model.OrderItemRequest) []model.OrderItemRequest {
+	merged := make([]model.OrderItemRequest, 0, len(items))
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		if i, ok := positions[item.PartUuid]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		positions[item.PartUuid] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
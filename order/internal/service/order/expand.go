@@ -0,0 +1,45 @@
+package order
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// expandTimeout bounds the inventory request of an expansion, so that a slow
+// inventory delays order reads only that long.
+const expandTimeout = 2 * time.Second
+
+func (s *service) ExpandParts(ctx context.Context, orders []*model.Order) *model.PartsExpansion {
+	seen := make(map[string]bool)
+	var uuids []string
+	for _, order := range orders {
+		for _, item := range order.Items {
+			if !seen[item.PartUuid] {
+				seen[item.PartUuid] = true
+				uuids = append(uuids, item.PartUuid)
+			}
+		}
+	}
+
+	expansion := &model.PartsExpansion{Parts: make(map[string]*model.Part, len(uuids))}
+	if len(uuids) == 0 {
+		return expansion
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, expandTimeout)
+	defer cancel()
+
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: uuids})
+	if err != nil {
+		log.Printf("failed to expand parts of %d orders: %v", len(orders), err)
+		expansion.Partial = true
+		return expansion
+	}
+	for _, part := range parts {
+		expansion.Parts[part.Uuid] = part
+	}
+	return expansion
+}
@@ -0,0 +1,46 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Expire cancels orders pending payment past their deadline. Pending orders
+// hold no stock, parts are taken from inventory only when an order is paid.
+// Orders changed since they were listed are skipped.
+func (s *service) Expire(ctx context.Context, limit int) (int, error) {
+	orders, err := s.orderRepository.ListExpired(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, order := range orders {
+		if err := ctx.Err(); err != nil {
+			return expired, err
+		}
+
+		seen := len(order.History)
+		if err := s.machine.Fire(ctx, order, model.OrderEventExpire, model.ActorSystem, "expired"); err != nil {
+			log.Printf("failed to expire order %s: %v", order.OrderUuid, err)
+			continue
+		}
+
+		err := s.orderRepository.Update(ctx, order)
+		if errors.Is(err, model.ErrOrderChanged) {
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		s.releasePromoCode(ctx, order)
+		s.publish(order, seen)
+		expired++
+	}
+
+	return expired, nil
+}
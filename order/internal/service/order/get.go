@@ -0,0 +1,11 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func (s *service) Get(ctx context.Context, uuid string) (*model.Order, error) {
+	return s.orderRepository.Get(ctx, uuid)
+}
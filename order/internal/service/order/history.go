@@ -0,0 +1,16 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func (s *service) History(ctx context.Context, uuid string) ([]model.StatusTransition, error) {
+	order, err := s.orderRepository.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return order.History, nil
+}
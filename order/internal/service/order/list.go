@@ -0,0 +1,16 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func (s *service) List(ctx context.Context, query model.OrdersQuery) (*model.OrdersPage, error) {
+	// A cursor is only valid for the sort order it was issued for.
+	if query.Cursor != nil && (query.Cursor.SortBy != query.SortBy || query.Cursor.Descending != query.Descending) {
+		return nil, model.ErrInvalidCursor
+	}
+
+	return s.orderRepository.List(ctx, query)
+}
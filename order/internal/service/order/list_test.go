@@ -0,0 +1,59 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderRepository "github.com/qyrlabs/test-backend/order/internal/repository/order"
+	promoRepository "github.com/qyrlabs/test-backend/order/internal/repository/promo"
+	streamService "github.com/qyrlabs/test-backend/order/internal/service/stream"
+)
+
+// TestListRejectsCursorOfOtherSort reuses the cursor of a page with another
+// sort field or direction, which must be rejected rather than skip orders.
+func TestListRejectsCursorOfOtherSort(t *testing.T) {
+	ctx := context.Background()
+
+	orders := orderRepository.NewRepository()
+	statusStream := streamService.NewService(16)
+	defer statusStream.Close()
+	s := NewService(orders, promoRepository.NewRepository(), &fakeInventory{}, &fakePayments{}, statusStream, model.PricingRules{}, model.OrderSettings{PaymentDeadline: time.Hour})
+
+	for range 3 {
+		if err := orders.Create(ctx, pendingOrder()); err != nil {
+			t.Fatalf("create order: %v", err)
+		}
+	}
+
+	first := model.OrdersQuery{SortBy: model.OrdersSortByCreatedAt, Descending: true, Limit: 1}
+	page, err := s.List(ctx, first)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if page.NextCursor == nil {
+		t.Fatal("first page has no next cursor")
+	}
+
+	tests := []struct {
+		name       string
+		sortBy     model.OrdersSortBy
+		descending bool
+		want       error
+	}{
+		{name: "same sort", sortBy: model.OrdersSortByCreatedAt, descending: true},
+		{name: "other field", sortBy: model.OrdersSortByTotalPriceMinor, descending: true, want: model.ErrInvalidCursor},
+		{name: "other direction", sortBy: model.OrdersSortByCreatedAt, descending: false, want: model.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := model.OrdersQuery{SortBy: tt.sortBy, Descending: tt.descending, Limit: 1, Cursor: page.NextCursor}
+			if _, err := s.List(ctx, query); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/statemachine"
+)
+
+// NewStateMachine returns the order lifecycle. Side effects are attached by NewService.
+//
+// A payment starts from pending payment only. The tenders of a split payment
+// after the first are paid from partially paid, which only the request that
+// started the payment reaches, so another payment cannot start meanwhile.
+// The order is claimed by the payment before the first tender is charged, and
+// other changes of a pending order wait until the claim ends, see claimPayment.
+// A split payment whose claim ran out is resumed under a new claim.
+func NewStateMachine() *statemachine.Machine {
+	refundable := []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusPartiallyRefunded}
+
+	return statemachine.New(model.OrderStatusPendingPayment,
+		statemachine.Transition{
+			Event:  model.OrderEventPay,
+			From:   []model.OrderStatus{model.OrderStatusPendingPayment},
+			To:     model.OrderStatusPaid,
+			Guards: []statemachine.Guard{tenderSelected, paymentDeadlineNotPassed, paymentNotInProgress},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventPartialPay,
+			From:   []model.OrderStatus{model.OrderStatusPendingPayment},
+			To:     model.OrderStatusPartiallyPaid,
+			Guards: []statemachine.Guard{tenderSelected, paymentDeadlineNotPassed, paymentNotInProgress},
+		},
+		// A split payment that has started may finish after the deadline.
+		statemachine.Transition{
+			Event:  model.OrderEventPayTender,
+			From:   []model.OrderStatus{model.OrderStatusPartiallyPaid},
+			To:     model.OrderStatusPartiallyPaid,
+			Guards: []statemachine.Guard{tenderSelected},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventCompletePayment,
+			From:   []model.OrderStatus{model.OrderStatusPartiallyPaid},
+			To:     model.OrderStatusPaid,
+			Guards: []statemachine.Guard{tenderSelected},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventResumePayment,
+			From:   []model.OrderStatus{model.OrderStatusPartiallyPaid},
+			To:     model.OrderStatusPartiallyPaid,
+			Guards: []statemachine.Guard{paymentNotInProgress},
+		},
+		statemachine.Transition{
+			Event: model.OrderEventRevertPayment,
+			From:  []model.OrderStatus{model.OrderStatusPartiallyPaid},
+			To:    model.OrderStatusPendingPayment,
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventEdit,
+			From:   []model.OrderStatus{model.OrderStatusPendingPayment},
+			To:     model.OrderStatusPendingPayment,
+			Guards: []statemachine.Guard{paymentDeadlineNotPassed, paymentNotInProgress},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventCancel,
+			From:   []model.OrderStatus{model.OrderStatusPendingPayment},
+			To:     model.OrderStatusCancelled,
+			Guards: []statemachine.Guard{paymentNotInProgress},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventExpire,
+			From:   []model.OrderStatus{model.OrderStatusPendingPayment},
+			To:     model.OrderStatusCancelled,
+			Guards: []statemachine.Guard{paymentDeadlinePassed, paymentNotInProgress},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventPartialRefund,
+			From:   refundable,
+			To:     model.OrderStatusPartiallyRefunded,
+			Guards: []statemachine.Guard{refundNotInProgress},
+		},
+		statemachine.Transition{
+			Event:  model.OrderEventRefund,
+			From:   refundable,
+			To:     model.OrderStatusRefunded,
+			Guards: []statemachine.Guard{refundNotInProgress},
+		},
+	)
+}
+
+func tenderSelected(ctx context.Context, order *model.Order) error {
+	tender, ok := order.NextTender()
+	if !ok || tender.PaymentMethod == model.PaymentMethodUnspecified {
+		return model.ErrInvalidPaymentMethod
+	}
+	return nil
+}
+
+func paymentDeadlineNotPassed(ctx context.Context, order *model.Order) error {
+	if time.Now().After(order.PaymentDeadline) {
+		return model.ErrPaymentDeadline
+	}
+	return nil
+}
+
+// paymentNotInProgress rejects changes of an order claimed by a payment. A
+// claim older than paymentHold is left by a request that did not finish and
+// no longer counts.
+func paymentNotInProgress(ctx context.Context, order *model.Order) error {
+	if order.PaymentStartedAt != nil && time.Since(*order.PaymentStartedAt) < paymentHold {
+		return fmt.Errorf("%w: payment of order %s is in progress", model.ErrTransitionNotAllowed, order.OrderUuid)
+	}
+	return nil
+}
+
+// refundNotInProgress rejects a refund while another one of the order is
+// being made. A pending refund older than refundHold was left by a request
+// that did not finish and no longer counts.
+func refundNotInProgress(ctx context.Context, order *model.Order) error {
+	if order.PendingRefund(time.Now().Add(-refundHold)) {
+		return fmt.Errorf("%w: refund of order %s is in progress", model.ErrTransitionNotAllowed, order.OrderUuid)
+	}
+	return nil
+}
+
+func paymentDeadlinePassed(ctx context.Context, order *model.Order) error {
+	if !time.Now().After(order.PaymentDeadline) {
+		return fmt.Errorf("%w: order %s is not expired", model.ErrTransitionNotAllowed, order.OrderUuid)
+	}
+	return nil
+}
+
+// claimPayment saves the tenders of the order before the first one is charged,
+// claiming the order for the payment. Concurrent payments and cancellations
+// fail to save or see the claim, and a retry of a payment that did not finish
+// charges the same tenders under the same keys.
+func (s *service) claimPayment(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+	claimed := order.PaymentStartedAt
+	order.PaymentStartedAt = &transition.At
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		order.PaymentStartedAt = claimed
+		return err
+	}
+	return nil
+}
+
+// chargeTender pays the next tender of the order in the payment service.
+// The first tender also takes the ordered parts from stock, they are
+// returned if its payment fails. Tenders charged before a failed one are
+// refunded by refundTenders.
+func (s *service) chargeTender(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+	tender, _ := order.NextTender()
+
+	var taken []model.StockAdjustment
+	if len(order.Payments) == 0 {
+		taken = orderedParts(order)
+		if err := s.inventoryClient.AdjustStock(ctx, taken); err != nil {
+			if errors.Is(err, model.ErrInsufficientStock) {
+				return err
+			}
+			return fmt.Errorf("%w: failed to take parts from stock: %w", model.ErrUpstream, err)
+		}
+	}
+
+	transactionUuid, err := s.paymentClient.PayOrder(ctx, order.OrderUuid, order.TenderUuid(len(order.Payments)), order.UserUuid, tender.PaymentMethod, tender.AmountMinor)
+	if err != nil {
+		if len(taken) > 0 {
+			s.restock(ctx, order.OrderUuid, invert(taken))
+		}
+		if errors.Is(err, model.ErrOrderAlreadyPaid) {
+			return err
+		}
+		return fmt.Errorf("%w: failed to pay %s tender: %w", model.ErrUpstream, tender.PaymentMethod, err)
+	}
+
+	order.AddPayment(model.Payment{
+		TransactionUuid: transactionUuid,
+		PaymentMethod:   tender.PaymentMethod,
+		AmountMinor:     tender.AmountMinor,
+		PaidAt:          transition.At,
+	})
+	return nil
+}
+
+// refundTenders refunds the tenders charged before a split payment failed.
+func (s *service) refundTenders(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+	s.releasePayments(ctx, order, transition.Reason)
+	return nil
+}
+
+// releasePayments refunds the payments of an attempt to pay the order that
+// did not go through and returns the ordered parts to stock. The attempt is
+// abandoned regardless, so failures are only logged.
+func (s *service) releasePayments(ctx context.Context, order *model.Order, reason string) {
+	for _, payment := range order.Payments {
+		if _, err := s.paymentClient.RefundPayment(ctx, payment.TransactionUuid, payment.ReleaseUuid(), payment.AmountMinor, reason); err != nil {
+			log.Printf("failed to refund payment %s of order %s: %v", payment.TransactionUuid, order.OrderUuid, err)
+		}
+	}
+	s.restock(ctx, order.OrderUuid, invert(orderedParts(order)))
+
+	order.ResetPayments()
+}
+
+// reserveRefund saves the last refund of the order as pending before it is
+// made. A concurrent refund fails to save or sees the pending one, so money
+// and parts are returned once.
+func (s *service) reserveRefund(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+	return s.orderRepository.Update(ctx, order)
+}
+
+// refundPayment returns parts of the last refund of the order to stock and
+// refunds its amount in the payment service. The parts are taken back if the
+// refund fails.
+func (s *service) refundPayment(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+	refund := &order.Refunds[len(order.Refunds)-1]
+
+	returned := make([]model.StockAdjustment, 0, len(refund.Items))
+	for _, item := range refund.Items {
+		returned = append(returned, model.StockAdjustment{PartUuid: item.PartUuid, Delta: item.Quantity})
+	}
+
+	if len(returned) > 0 {
+		if err := s.inventoryClient.AdjustStock(ctx, returned); err != nil {
+			return fmt.Errorf("%w: failed to return parts to stock: %w", model.ErrUpstream, err)
+		}
+	}
+
+	transactionUuid, err := s.refundPayments(ctx, order, refund)
+	if err != nil {
+		if len(returned) > 0 {
+			s.restock(ctx, order.OrderUuid, invert(returned))
+		}
+		return fmt.Errorf("%w: failed to refund payment: %w", model.ErrUpstream, err)
+	}
+
+	refund.TransactionUuid = transactionUuid
+	refund.Pending = false
+	return nil
+}
+
+// refundPayments refunds the amount of the refund from the payments of the
+// order, the latest payment first, and returns the UUID of the first refund
+// transaction. Refunds made before a failed one cannot be undone and are
+// only logged.
+func (s *service) refundPayments(ctx context.Context, order *model.Order, refund *model.Refund) (string, error) {
+	amountMinor := refund.AmountMinor
+	var first string
+	for i := len(order.Payments) - 1; i >= 0 && amountMinor > 0; i-- {
+		payment := &order.Payments[i]
+		chunk := min(amountMinor, payment.AmountMinor-payment.RefundedMinor)
+		if chunk == 0 {
+			continue
+		}
+
+		transactionUuid, err := s.paymentClient.RefundPayment(ctx, payment.TransactionUuid, refund.PaymentRefundUuid(payment.TransactionUuid), chunk, refund.Reason)
+		if err != nil {
+			if first != "" {
+				log.Printf("refund of order %s failed after refund %s was made: %v", order.OrderUuid, first, err)
+			}
+			return "", err
+		}
+
+		payment.RefundedMinor += chunk
+		amountMinor -= chunk
+		if first == "" {
+			first = transactionUuid
+		}
+	}
+	return first, nil
+}
+
+// releasePromoCode frees the use of the promo code by a cancelled order. It is
+// called once the cancellation is saved, so that a cancellation that lost a
+// race keeps the code redeemed. The order is cancelled regardless, so a
+// failure is only logged.
+func (s *service) releasePromoCode(ctx context.Context, order *model.Order) {
+	if order.PromoCode == "" {
+		return
+	}
+	if err := s.promoCodeRepository.Release(ctx, order.PromoCode, order.OrderUuid); err != nil {
+		log.Printf("failed to release promo code %s of order %s: %v", order.PromoCode, order.OrderUuid, err)
+	}
+}
+
+// restock reverts a stock adjustment after a failed payment call. A failure
+// leaves the stock inconsistent and is only logged.
+func (s *service) restock(ctx context.Context, orderUuid string, adjustments []model.StockAdjustment) {
+	if err := s.inventoryClient.AdjustStock(ctx, adjustments); err != nil {
+		log.Printf("failed to revert stock adjustment of order %s: %v", orderUuid, err)
+	}
+}
+
+// orderedParts returns the adjustment taking the ordered parts from stock.
+func orderedParts(order *model.Order) []model.StockAdjustment {
+	taken := make([]model.StockAdjustment, 0, len(order.Items))
+	for _, item := range order.Items {
+		taken = append(taken, model.StockAdjustment{PartUuid: item.PartUuid, Delta: -item.Quantity})
+	}
+	return taken
+}
+
+func invert(adjustments []model.StockAdjustment) []model.StockAdjustment {
+	inverted := make([]model.StockAdjustment, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		inverted = append(inverted, model.StockAdjustment{PartUuid: adjustment.PartUuid, Delta: -adjustment.Delta})
+	}
+	return inverted
+}
+
+// raise returns a hook raising a webhook event of eventType for the transition.
+// The event is stored in the outbox when the order is saved.
+func raise(eventType model.WebhookEventType) statemachine.Hook {
+	return func(ctx context.Context, order *model.Order, transition model.StatusTransition) error {
+		order.Raise(eventType, transition.At)
+		return nil
+	}
+}
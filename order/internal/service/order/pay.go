@@ -0,0 +1,253 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// paymentHold is how long a payment claims the order. The payment is given
+// up once the hold is over, so that the order is not changed under it.
+const paymentHold = 30 * time.Second
+
+func (s *service) Pay(ctx context.Context, uuid string, req model.PayRequest, ifMatch []int64) (*model.Order, error) {
+	order, err := s.orderRepository.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(order, ifMatch); err != nil {
+		return nil, err
+	}
+
+	tenders, err := newTenders(order, req)
+	if err != nil {
+		return nil, err
+	}
+	if s.machine.Can(order.Status, tenderEvent(0, len(tenders))) {
+		if err := s.recheckItems(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := len(order.History)
+	ctx, cancel := context.WithTimeout(ctx, paymentHold)
+	defer cancel()
+
+	// The partially paid order is saved after every tender but the last, so
+	// that the paid amount is visible while the rest is charged.
+	first, saved := 0, false
+	if s.machine.Can(order.Status, model.OrderEventResumePayment) {
+		if !slices.Equal(order.Tenders, tenders) {
+			return nil, fmt.Errorf("%w: payment of order %s was started with other tenders", model.ErrInvalidTenders, order.OrderUuid)
+		}
+		if err := s.machine.Fire(ctx, order, model.OrderEventResumePayment, model.UserActor(order.UserUuid), "payment resumed"); err != nil {
+			return nil, err
+		}
+		first, saved = len(order.Payments), true
+	}
+	order.SelectTenders(tenders)
+	claimed := order.PaymentStartedAt
+
+	for i := first; i < len(tenders); i++ {
+		tender := tenders[i]
+		event, reason := tenderEvent(i, len(tenders)), "paid by "+tender.PaymentMethod.String()
+		if len(tenders) > 1 {
+			reason = fmt.Sprintf("paid %d of %d by %s", order.PaidMinor+tender.AmountMinor, order.TotalPriceMinor, tender.PaymentMethod)
+		}
+
+		err := s.machine.Fire(ctx, order, event, model.UserActor(order.UserUuid), reason)
+		if err == nil && i < len(tenders)-1 {
+			err = s.orderRepository.Update(ctx, order)
+			if err == nil {
+				saved = true
+				s.publish(order, seen)
+				seen = len(order.History)
+			}
+		}
+		if err != nil {
+			switch {
+			case s.machine.Can(order.Status, model.OrderEventRevertPayment):
+				s.revertPayment(ctx, order, saved, seen, err)
+			case order.PaymentStartedAt != claimed:
+				s.releaseClaim(ctx, order)
+			}
+			return nil, err
+		}
+	}
+
+	order.PaymentStartedAt = nil
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		// The claim ran out and the order was changed meanwhile, so this
+		// payment is taken back.
+		if errors.Is(err, model.ErrOrderChanged) {
+			s.releasePayments(context.WithoutCancel(ctx), order, "payment not saved: "+err.Error())
+		}
+		return nil, err
+	}
+	s.publish(order, seen)
+
+	return order, nil
+}
+
+// tenderEvent returns the event paying the tender at index of count tenders.
+func tenderEvent(index, count int) model.OrderEvent {
+	switch {
+	case count == 1:
+		return model.OrderEventPay
+	case index == 0:
+		return model.OrderEventPartialPay
+	case index == count-1:
+		return model.OrderEventCompletePayment
+	default:
+		return model.OrderEventPayTender
+	}
+}
+
+// releaseClaim ends the claim of a payment that failed before a tender was
+// charged. The tenders and their keys are kept for a retry, which gets a
+// payment made by a call that failed to respond. A failure is only logged,
+// the claim runs out by itself.
+func (s *service) releaseClaim(ctx context.Context, order *model.Order) {
+	order.PaymentStartedAt = nil
+	if err := s.orderRepository.Update(context.WithoutCancel(ctx), order); err != nil {
+		log.Printf("failed to release payment claim of order %s: %v", order.OrderUuid, err)
+	}
+}
+
+// revertPayment refunds the tenders charged before the split payment of the
+// order failed with cause and returns the order to pending payment. The order
+// is saved only if its partially paid status was. Compensation runs even if
+// the request is cancelled, and its failures are only logged.
+func (s *service) revertPayment(ctx context.Context, order *model.Order, saved bool, seen int, cause error) {
+	ctx = context.WithoutCancel(ctx)
+
+	err := s.machine.Fire(ctx, order, model.OrderEventRevertPayment, model.ActorSystem, "payment failed: "+cause.Error())
+	if err != nil {
+		log.Printf("failed to revert payment of order %s: %v", order.OrderUuid, err)
+		return
+	}
+	if !saved {
+		return
+	}
+
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		log.Printf("failed to save reverted payment of order %s: %v", order.OrderUuid, err)
+		return
+	}
+	s.publish(order, seen)
+}
+
+// recheckItems compares the ordered parts with inventory before the order is
+// paid. Discontinued and out of stock parts fail the payment. Price changes
+// are handled by the price change policy: the original prices are charged,
+// the payment fails, or the order is repriced at current prices and saved so
+// that the customer confirms the new total by paying again.
+func (s *service) recheckItems(ctx context.Context, order *model.Order) error {
+	partUuids := make([]string, 0, len(order.Items))
+	for _, item := range order.Items {
+		partUuids = append(partUuids, item.PartUuid)
+	}
+
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{
+		Uuids:     partUuids,
+		SkipCache: true,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to get filtered parts: %w", model.ErrUpstream, err)
+	}
+
+	partsByUuid := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		partsByUuid[part.Uuid] = part
+	}
+
+	var changes []model.ItemChange
+	unavailable := false
+	for _, item := range order.Items {
+		change := model.ItemChange{
+			PartUuid:          item.PartUuid,
+			PartName:          item.PartName,
+			Quantity:          item.Quantity,
+			OrderedPriceMinor: item.UnitPriceMinor,
+		}
+
+		part, ok := partsByUuid[item.PartUuid]
+		if ok {
+			change.CurrentPriceMinor = part.PriceMinor
+			change.StockQuantity = part.StockQuantity
+		}
+		switch {
+		case !ok:
+			change.Kind = model.ItemChangeKindDiscontinued
+		case part.StockQuantity < item.Quantity:
+			change.Kind = model.ItemChangeKindOutOfStock
+		case part.PriceMinor != item.UnitPriceMinor:
+			change.Kind = model.ItemChangeKindPriceChanged
+		default:
+			continue
+		}
+
+		unavailable = unavailable || change.Kind != model.ItemChangeKindPriceChanged
+		changes = append(changes, change)
+	}
+
+	policy := s.settings.Load().PriceChangePolicy
+	switch {
+	case len(changes) == 0:
+		return nil
+	case unavailable || policy == model.PriceChangePolicyFail:
+		return &model.ItemsChangedError{Changes: changes}
+	case policy == model.PriceChangePolicyHonor:
+		return nil
+	}
+
+	items := make([]model.OrderItemRequest, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, model.OrderItemRequest{PartUuid: item.PartUuid, Quantity: item.Quantity})
+	}
+	// As in Update, the promo code applies as of when the order was placed.
+	if err := s.price(ctx, order, items, order.PromoCode, order.CreatedAt); err != nil {
+		return err
+	}
+	order.Raise(model.WebhookEventTypeOrderUpdated, time.Now())
+
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		return err
+	}
+
+	return &model.ItemsChangedError{Changes: changes, Repriced: order}
+}
+
+// newTenders validates the request against the order and returns the tenders to charge.
+func newTenders(order *model.Order, req model.PayRequest) ([]model.Tender, error) {
+	switch {
+	case len(req.Tenders) > 0 && req.PaymentMethod != model.PaymentMethodUnspecified:
+		return nil, fmt.Errorf("%w: payment method and tenders are mutually exclusive", model.ErrInvalidTenders)
+	case len(req.Tenders) == 0:
+		if req.PaymentMethod == model.PaymentMethodUnspecified {
+			return nil, model.ErrInvalidPaymentMethod
+		}
+		return []model.Tender{{PaymentMethod: req.PaymentMethod, AmountMinor: order.TotalPriceMinor}}, nil
+	}
+
+	var sum int64
+	for i, tender := range req.Tenders {
+		if tender.PaymentMethod == model.PaymentMethodUnspecified {
+			return nil, fmt.Errorf("%w: tender %d", model.ErrInvalidPaymentMethod, i)
+		}
+		if tender.AmountMinor <= 0 {
+			return nil, fmt.Errorf("%w: amount of tender %d must be positive", model.ErrInvalidTenders, i)
+		}
+		sum += tender.AmountMinor
+	}
+	if sum != order.TotalPriceMinor {
+		return nil, fmt.Errorf("%w: tenders add up to %d, order total is %d", model.ErrInvalidTenders, sum, order.TotalPriceMinor)
+	}
+
+	return req.Tenders, nil
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	orderRepository "github.com/qyrlabs/test-backend/order/internal/repository/order"
+	promoRepository "github.com/qyrlabs/test-backend/order/internal/repository/promo"
+	streamService "github.com/qyrlabs/test-backend/order/internal/service/stream"
+)
+
+// TestPayRetryChargesOnce pays an order whose paid status fails to be saved,
+// as if the service stopped after charging, and retries once the claim of the
+// payment ran out. The retry must get the payment already made.
+func TestPayRetryChargesOnce(t *testing.T) {
+	ctx := context.Background()
+
+	orders := &failingOrders{OrderRepository: orderRepository.NewRepository()}
+	order := pendingOrder()
+	inventory := &fakeInventory{parts: []*model.Part{{
+		Uuid:          order.Items[0].PartUuid,
+		Name:          order.Items[0].PartName,
+		PriceMinor:    order.Items[0].UnitPriceMinor,
+		StockQuantity: order.Items[0].Quantity,
+	}}}
+	payments := &fakePayments{}
+	statusStream := streamService.NewService(16)
+	defer statusStream.Close()
+	s := NewService(orders, promoRepository.NewRepository(), inventory, payments, statusStream, model.PricingRules{}, model.OrderSettings{PaymentDeadline: time.Hour})
+
+	if err := orders.Create(ctx, order); err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+	req := model.PayRequest{Tenders: []model.Tender{
+		{PaymentMethod: model.PaymentMethodCard, AmountMinor: 1000},
+		{PaymentMethod: model.PaymentMethodSbp, AmountMinor: order.TotalPriceMinor - 1000},
+	}}
+
+	orders.failPaid = true
+	if _, err := s.Pay(ctx, order.OrderUuid, req, nil); !errors.Is(err, errSaveFailed) {
+		t.Fatalf("pay: got %v, want %v", err, errSaveFailed)
+	}
+	orders.failPaid = false
+
+	if _, err := s.Pay(ctx, order.OrderUuid, req, nil); !errors.Is(err, model.ErrTransitionNotAllowed) {
+		t.Fatalf("pay while claimed: got %v, want %v", err, model.ErrTransitionNotAllowed)
+	}
+
+	stored, err := orders.Get(ctx, order.OrderUuid)
+	if err != nil {
+		t.Fatalf("get order: %v", err)
+	}
+	expired := time.Now().Add(-paymentHold)
+	stored.PaymentStartedAt = &expired
+	if err := orders.Update(ctx, stored); err != nil {
+		t.Fatalf("expire claim: %v", err)
+	}
+
+	paid, err := s.Pay(ctx, order.OrderUuid, req, nil)
+	if err != nil {
+		t.Fatalf("retry pay: %v", err)
+	}
+	if paid.Status != model.OrderStatusPaid || paid.PaymentStartedAt != nil {
+		t.Fatalf("retried order is %s, claimed at %v", paid.Status, paid.PaymentStartedAt)
+	}
+	if charged := payments.net(); charged != order.TotalPriceMinor {
+		t.Fatalf("retried order charged %d, want %d", charged, order.TotalPriceMinor)
+	}
+}
+
+var errSaveFailed = errors.New("save failed")
+
+// failingOrders fails to save paid orders while failPaid is set.
+type failingOrders struct {
+	repository.OrderRepository
+	failPaid bool
+}
+
+func (f *failingOrders) Update(ctx context.Context, order *model.Order) error {
+	if f.failPaid && order.Status == model.OrderStatusPaid {
+		return errSaveFailed
+	}
+	return f.OrderRepository.Update(ctx, order)
+}
@@ -0,0 +1,18 @@
+package order
+
+import (
+	"github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/pricing"
+)
+
+// NewPricingPipeline returns the order pricing: line totals, promo code
+// discount, shipping and then VAT, which is also charged on shipping.
+func NewPricingPipeline(rules model.PricingRules, inventoryClient grpc.InventoryClient) *pricing.Pipeline {
+	return pricing.New(
+		pricing.Subtotal,
+		pricing.Discount,
+		pricing.Shipping(rules.ShippingRates, inventoryClient),
+		pricing.Tax(rules.TaxRules),
+	)
+}
@@ -0,0 +1,296 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderRepository "github.com/qyrlabs/test-backend/order/internal/repository/order"
+	promoRepository "github.com/qyrlabs/test-backend/order/internal/repository/promo"
+	streamService "github.com/qyrlabs/test-backend/order/internal/service/stream"
+)
+
+// TestPayAndCancelAreMutuallyExclusive races a payment against a cancellation
+// of the same order. Exactly one of them must win, and money and stock taken
+// by a payment that lost must be returned. Run with -race.
+func TestPayAndCancelAreMutuallyExclusive(t *testing.T) {
+	const rounds = 200
+
+	ctx := context.Background()
+	won := map[string]int{}
+
+	for range rounds {
+		orders := orderRepository.NewRepository()
+		order := pendingOrder()
+		inventory := &fakeInventory{parts: []*model.Part{{
+			Uuid:          order.Items[0].PartUuid,
+			Name:          order.Items[0].PartName,
+			PriceMinor:    order.Items[0].UnitPriceMinor,
+			StockQuantity: order.Items[0].Quantity,
+		}}}
+		payments := &fakePayments{}
+		statusStream := streamService.NewService(16)
+		s := NewService(orders, promoRepository.NewRepository(), inventory, payments, statusStream, model.PricingRules{}, model.OrderSettings{PaymentDeadline: time.Hour})
+
+		if err := orders.Create(ctx, order); err != nil {
+			t.Fatalf("create order: %v", err)
+		}
+
+		var (
+			wg                sync.WaitGroup
+			payErr, cancelErr error
+		)
+		start := make(chan struct{})
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, payErr = s.Pay(ctx, order.OrderUuid, model.PayRequest{PaymentMethod: model.PaymentMethodCard}, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			_, cancelErr = s.Cancel(ctx, order.OrderUuid, nil)
+		}()
+		close(start)
+		wg.Wait()
+		statusStream.Close()
+
+		stored, err := orders.Get(ctx, order.OrderUuid)
+		if err != nil {
+			t.Fatalf("get order: %v", err)
+		}
+
+		switch {
+		case payErr == nil && cancelErr == nil:
+			t.Fatalf("both pay and cancel succeeded, order is %s", stored.Status)
+		case payErr == nil:
+			won["pay"]++
+			if !lostRace(cancelErr) {
+				t.Fatalf("cancel failed with unexpected error: %v", cancelErr)
+			}
+			if stored.Status != model.OrderStatusPaid || stored.CancelledAt != nil {
+				t.Fatalf("paid order is %s, cancelled at %v", stored.Status, stored.CancelledAt)
+			}
+			if charged := payments.net(); charged != order.TotalPriceMinor {
+				t.Fatalf("paid order charged %d, want %d", charged, order.TotalPriceMinor)
+			}
+			if taken := inventory.net(); taken != -order.Items[0].Quantity {
+				t.Fatalf("paid order took %d parts from stock, want %d", -taken, order.Items[0].Quantity)
+			}
+		case cancelErr == nil:
+			won["cancel"]++
+			if !lostRace(payErr) {
+				t.Fatalf("pay failed with unexpected error: %v", payErr)
+			}
+			if stored.Status != model.OrderStatusCancelled || stored.PaidAt != nil || len(stored.Payments) > 0 {
+				t.Fatalf("cancelled order is %s, paid at %v with %d payments", stored.Status, stored.PaidAt, len(stored.Payments))
+			}
+			if charged := payments.net(); charged != 0 {
+				t.Fatalf("cancelled order charged %d", charged)
+			}
+			if taken := inventory.net(); taken != 0 {
+				t.Fatalf("cancelled order took %d parts from stock", -taken)
+			}
+		default:
+			t.Fatalf("both failed: pay: %v, cancel: %v", payErr, cancelErr)
+		}
+	}
+
+	t.Logf("%d rounds won: %v", rounds, won)
+}
+
+// TestConcurrentRefundsAreRecorded races a refund of one part against a
+// refund of the same amount of money. Money and parts returned must match the
+// refunds saved with the order, the last of which returns the parts left.
+// Run with -race.
+func TestConcurrentRefundsAreRecorded(t *testing.T) {
+	const rounds = 200
+
+	ctx := context.Background()
+
+	for range rounds {
+		orders := orderRepository.NewRepository()
+		order := pendingOrder()
+		inventory := &fakeInventory{parts: []*model.Part{{
+			Uuid:          order.Items[0].PartUuid,
+			Name:          order.Items[0].PartName,
+			PriceMinor:    order.Items[0].UnitPriceMinor,
+			StockQuantity: order.Items[0].Quantity,
+		}}}
+		payments := &fakePayments{}
+		statusStream := streamService.NewService(16)
+		s := NewService(orders, promoRepository.NewRepository(), inventory, payments, statusStream, model.PricingRules{}, model.OrderSettings{PaymentDeadline: time.Hour})
+
+		if err := orders.Create(ctx, order); err != nil {
+			t.Fatalf("create order: %v", err)
+		}
+		if _, err := s.Pay(ctx, order.OrderUuid, model.PayRequest{PaymentMethod: model.PaymentMethodCard}, nil); err != nil {
+			t.Fatalf("pay order: %v", err)
+		}
+
+		requests := []model.RefundRequest{
+			{Items: []model.RefundItem{{PartUuid: order.Items[0].PartUuid, Quantity: 1}}},
+			{AmountMinor: order.Items[0].UnitPriceMinor},
+		}
+		var wg sync.WaitGroup
+		errs := make([]error, len(requests))
+		start := make(chan struct{})
+		for i, req := range requests {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				_, errs[i] = s.Refund(ctx, order.OrderUuid, req, nil)
+			}()
+		}
+		close(start)
+		wg.Wait()
+		statusStream.Close()
+
+		for _, err := range errs {
+			if err != nil && !lostRace(err) {
+				t.Fatalf("refund failed with unexpected error: %v", err)
+			}
+		}
+
+		stored, err := orders.Get(ctx, order.OrderUuid)
+		if err != nil {
+			t.Fatalf("get order: %v", err)
+		}
+		for _, refund := range stored.Refunds {
+			if refund.Pending {
+				t.Fatalf("refund %+v left pending", refund)
+			}
+		}
+		if charged := payments.net(); charged != order.TotalPriceMinor-stored.RefundedMinor {
+			t.Fatalf("order refunded %d, charged %d of %d", stored.RefundedMinor, charged, order.TotalPriceMinor)
+		}
+		if taken, kept := inventory.net(), stored.Items[0].Quantity-stored.Items[0].RefundedQuantity; taken != -kept {
+			t.Fatalf("order keeps %d parts, took %d from stock", kept, -taken)
+		}
+	}
+}
+
+// lostRace reports whether err is how an operation that lost the race to
+// another change of the order fails.
+func lostRace(err error) bool {
+	return errors.Is(err, model.ErrOrderChanged) || errors.Is(err, model.ErrTransitionNotAllowed)
+}
+
+func pendingOrder() *model.Order {
+	now := time.Now()
+	order := &model.Order{
+		OrderUuid: uuid.NewString(),
+		UserUuid:  uuid.NewString(),
+		Items: []model.OrderItem{{
+			PartUuid:       uuid.NewString(),
+			PartName:       "porthole",
+			Quantity:       2,
+			UnitPriceMinor: 1500,
+			LineTotalMinor: 3000,
+		}},
+		SubtotalMinor:   3000,
+		TotalPriceMinor: 3000,
+		Status:          model.OrderStatusPendingPayment,
+		CreatedAt:       now,
+		PaymentDeadline: now.Add(time.Hour),
+	}
+	return order
+}
+
+// fakeInventory lists the given parts and sums the stock adjustments of all parts.
+type fakeInventory struct {
+	parts []*model.Part
+
+	mu    sync.Mutex
+	delta int64
+}
+
+func (f *fakeInventory) ListParts(ctx context.Context, filter model.PartsFilter) ([]*model.Part, error) {
+	return f.parts, nil
+}
+
+func (f *fakeInventory) AdjustStock(ctx context.Context, adjustments []model.StockAdjustment) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, adjustment := range adjustments {
+		f.delta += adjustment.Delta
+	}
+	return nil
+}
+
+func (f *fakeInventory) GetShippingInfo(ctx context.Context, items []model.OrderItemRequest) (*model.ShippingInfo, error) {
+	return &model.ShippingInfo{}, nil
+}
+
+func (f *fakeInventory) net() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.delta
+}
+
+// fakePayments keeps the amount charged and not refunded. Like the payment
+// service, it charges a tender and makes a refund once and returns their
+// transactions to retries.
+type fakePayments struct {
+	mu       sync.Mutex
+	payments map[string]int64
+	tenders  map[string]string
+	refunds  map[string]string
+	charged  int64
+}
+
+func (f *fakePayments) PayOrder(ctx context.Context, orderUuid, tenderUuid, userUuid string, paymentMethod model.PaymentMethod, amountMinor int64) (string, error) {
+	// Lets the cancellation run while the payment is in flight.
+	runtime.Gosched()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.payments == nil {
+		f.payments = make(map[string]int64)
+		f.tenders = make(map[string]string)
+	}
+	if transactionUuid, ok := f.tenders[orderUuid+"/"+tenderUuid]; ok {
+		return transactionUuid, nil
+	}
+	transactionUuid := uuid.NewString()
+	f.tenders[orderUuid+"/"+tenderUuid] = transactionUuid
+	f.payments[transactionUuid] = amountMinor
+	f.charged += amountMinor
+	return transactionUuid, nil
+}
+
+func (f *fakePayments) RefundPayment(ctx context.Context, transactionUuid, refundUuid string, amountMinor int64, reason string) (string, error) {
+	// Lets a concurrent refund run while this one is in flight.
+	runtime.Gosched()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.refunds == nil {
+		f.refunds = make(map[string]string)
+	}
+	if refundTransactionUuid, ok := f.refunds[refundUuid]; ok {
+		return refundTransactionUuid, nil
+	}
+	if amountMinor > f.payments[transactionUuid] {
+		return "", errors.New("refund exceeds payment")
+	}
+	f.payments[transactionUuid] -= amountMinor
+	f.charged -= amountMinor
+	refundTransactionUuid := uuid.NewString()
+	f.refunds[refundUuid] = refundTransactionUuid
+	return refundTransactionUuid, nil
+}
+
+func (f *fakePayments) net() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.charged
+}
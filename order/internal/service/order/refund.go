@@ -0,0 +1,174 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// refundHold is how long a pending refund keeps other refunds of the order
+// from being made. The refund is given up once the hold is over.
+const refundHold = 30 * time.Second
+
+func (s *service) Refund(ctx context.Context, uuid string, req model.RefundRequest, ifMatch []int64) (*model.Order, error) {
+	order, err := s.orderRepository.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(order, ifMatch); err != nil {
+		return nil, err
+	}
+
+	seen := len(order.History)
+	refund, err := newRefund(order, req)
+	if err != nil {
+		return nil, err
+	}
+	order.AddRefund(refund)
+
+	event := model.OrderEventPartialRefund
+	if order.RemainingMinor() == 0 {
+		event = model.OrderEventRefund
+	}
+
+	reason := "refunded by user"
+	if req.Reason != "" {
+		reason += ": " + req.Reason
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, refundHold)
+	defer cancel()
+
+	version := order.Version
+	err = s.machine.Fire(ctx, order, event, model.UserActor(order.UserUuid), reason)
+	if err != nil {
+		// The refund was reserved but not made.
+		if order.Version != version {
+			s.dropRefund(ctx, order)
+		}
+		return nil, err
+	}
+
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		// The money is back with the user, the refund stays pending.
+		log.Printf("refund %s of order %s was made but not saved: %v", order.Refunds[len(order.Refunds)-1].TransactionUuid, order.OrderUuid, err)
+		return nil, err
+	}
+	s.publish(order, seen)
+
+	return order, nil
+}
+
+// dropRefund removes a reserved refund that failed to be made. A failure is
+// only logged, the refund stays pending and counts as refunded.
+func (s *service) dropRefund(ctx context.Context, order *model.Order) {
+	order.DropRefund()
+	if err := s.orderRepository.Update(context.WithoutCancel(ctx), order); err != nil {
+		log.Printf("failed to drop refund of order %s that was not made: %v", order.OrderUuid, err)
+	}
+}
+
+// newRefund validates the request against the order and derives the refunded amount and items.
+func newRefund(order *model.Order, req model.RefundRequest) (model.Refund, error) {
+	refund := model.Refund{
+		Uuid:      uuid.NewString(),
+		Reason:    req.Reason,
+		CreatedAt: time.Now(),
+		Pending:   true,
+	}
+
+	switch {
+	case len(req.Items) > 0 && req.AmountMinor > 0:
+		return model.Refund{}, fmt.Errorf("%w: items and amount are mutually exclusive", model.ErrInvalidRefund)
+	case len(req.Items) > 0:
+		requested := mergeRefundItems(req.Items)
+		for _, item := range requested {
+			line, ok := findItem(order, item.PartUuid)
+			if !ok {
+				return model.Refund{}, fmt.Errorf("%w: part %s is not in the order", model.ErrInvalidRefund, item.PartUuid)
+			}
+			if left := line.Quantity - line.RefundedQuantity; item.Quantity > left {
+				return model.Refund{}, fmt.Errorf("%w: part %s has %d left to refund, %d requested", model.ErrInvalidRefund, item.PartUuid, left, item.Quantity)
+			}
+			// Refunded at the discounted line price with tax, rounded down.
+			refund.AmountMinor += (line.LineTotalMinor - line.DiscountMinor + line.TaxMinor) * item.Quantity / line.Quantity
+		}
+		refund.Items = requested
+		// The refund returning the last parts refunds the rest of the order,
+		// including shipping and the amounts rounded down before.
+		if returnsRemainingItems(order, requested) {
+			refund.AmountMinor = order.RemainingMinor()
+		}
+	case req.AmountMinor > 0:
+		refund.AmountMinor = req.AmountMinor
+		// The last refund returns the parts not returned yet.
+		if refund.AmountMinor == order.RemainingMinor() {
+			refund.Items = remainingItems(order)
+		}
+	default:
+		// Full refund of whatever is left.
+		refund.Items = remainingItems(order)
+		refund.AmountMinor = order.RemainingMinor()
+	}
+
+	if refund.AmountMinor > order.RemainingMinor() {
+		return model.Refund{}, fmt.Errorf("%w: %d requested, %d left to refund", model.ErrInvalidRefund, refund.AmountMinor, order.RemainingMinor())
+	}
+
+	return refund, nil
+}
+
+// remainingItems returns the ordered parts not returned to inventory yet.
+func remainingItems(order *model.Order) []model.RefundItem {
+	var items []model.RefundItem
+	for _, line := range order.Items {
+		if left := line.Quantity - line.RefundedQuantity; left > 0 {
+			items = append(items, model.RefundItem{PartUuid: line.PartUuid, Quantity: left})
+		}
+	}
+	return items
+}
+
+// returnsRemainingItems reports whether the items are all the ordered parts
+// not returned to inventory yet.
+func returnsRemainingItems(order *model.Order, items []model.RefundItem) bool {
+	returned := make(map[string]int64, len(items))
+	for _, item := range items {
+		returned[item.PartUuid] += item.Quantity
+	}
+	for _, line := range order.Items {
+		if line.Quantity-line.RefundedQuantity != returned[line.PartUuid] {
+			return false
+		}
+	}
+	return true
+}
+
+func findItem(order *model.Order, partUuid string) (model.OrderItem, bool) {
+	for _, item := range order.Items {
+		if item.PartUuid == partUuid {
+			return item, true
+		}
+	}
+	return model.OrderItem{}, false
+}
+
+// mergeRefundItems sums quantities of items with the same part, keeping the order of first occurrence.
+func mergeRefundItems(items []model.RefundItem) []model.RefundItem {
+	merged := make([]model.RefundItem, 0, len(items))
+	positions := make(map[string]int, len(items))
+	for _, item := range items {
+		if i, ok := positions[item.PartUuid]; ok {
+			merged[i].Quantity += item.Quantity
+			continue
+		}
+		positions[item.PartUuid] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
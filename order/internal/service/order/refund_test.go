@@ -0,0 +1,119 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	orderRepository "github.com/qyrlabs/test-backend/order/internal/repository/order"
+	promoRepository "github.com/qyrlabs/test-backend/order/internal/repository/promo"
+	streamService "github.com/qyrlabs/test-backend/order/internal/service/stream"
+)
+
+// TestRefundOfLastItemsRefundsRest refunds an order with shipping and a line
+// total not divisible by its quantity. The refund returning the last parts
+// must refund the rest of the order, so that it ends up refunded in full.
+func TestRefundOfLastItemsRefundsRest(t *testing.T) {
+	partUuid := uuid.NewString()
+	items := func(quantity int64) model.RefundRequest {
+		return model.RefundRequest{Items: []model.RefundItem{{PartUuid: partUuid, Quantity: quantity}}}
+	}
+
+	tests := []struct {
+		name     string
+		requests []model.RefundRequest
+		// Amount of each refund in minor units.
+		want []int64
+	}{
+		{
+			name:     "all parts at once",
+			requests: []model.RefundRequest{items(3)},
+			want:     []int64{3981},
+		},
+		{
+			name:     "parts one by one",
+			requests: []model.RefundRequest{items(1), items(1), items(1)},
+			want:     []int64{1160, 1160, 1661},
+		},
+		{
+			name:     "money then parts",
+			requests: []model.RefundRequest{{AmountMinor: 2000}, items(1), items(2)},
+			want:     []int64{2000, 1160, 821},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			orders := orderRepository.NewRepository()
+			order := shippedOrder(partUuid)
+			inventory := &fakeInventory{parts: []*model.Part{{
+				Uuid:          partUuid,
+				Name:          order.Items[0].PartName,
+				PriceMinor:    order.Items[0].UnitPriceMinor,
+				StockQuantity: order.Items[0].Quantity,
+			}}}
+			payments := &fakePayments{}
+			statusStream := streamService.NewService(16)
+			defer statusStream.Close()
+			s := NewService(orders, promoRepository.NewRepository(), inventory, payments, statusStream, model.PricingRules{}, model.OrderSettings{PaymentDeadline: time.Hour})
+
+			if err := orders.Create(ctx, order); err != nil {
+				t.Fatalf("create order: %v", err)
+			}
+			if _, err := s.Pay(ctx, order.OrderUuid, model.PayRequest{PaymentMethod: model.PaymentMethodCard}, nil); err != nil {
+				t.Fatalf("pay order: %v", err)
+			}
+
+			var refunded *model.Order
+			for i, req := range tt.requests {
+				var err error
+				if refunded, err = s.Refund(ctx, order.OrderUuid, req, nil); err != nil {
+					t.Fatalf("refund %d: %v", i, err)
+				}
+				if got := refunded.Refunds[i].AmountMinor; got != tt.want[i] {
+					t.Errorf("refund %d is %d, want %d", i, got, tt.want[i])
+				}
+			}
+
+			if refunded.Status != model.OrderStatusRefunded {
+				t.Errorf("order is %s, want %s", refunded.Status, model.OrderStatusRefunded)
+			}
+			if refunded.RemainingMinor() != 0 || payments.net() != 0 {
+				t.Errorf("order has %d left to refund, %d charged", refunded.RemainingMinor(), payments.net())
+			}
+		})
+	}
+}
+
+// shippedOrder returns an order pending payment of three parts, whose
+// discounted line total with tax of 3481 leaves 1 after refunding 1160 per
+// part, and 500 of shipping.
+func shippedOrder(partUuid string) *model.Order {
+	now := time.Now()
+	return &model.Order{
+		OrderUuid: uuid.NewString(),
+		UserUuid:  uuid.NewString(),
+		Items: []model.OrderItem{{
+			PartUuid:       partUuid,
+			PartName:       "porthole",
+			Quantity:       3,
+			UnitPriceMinor: 1000,
+			LineTotalMinor: 3000,
+			DiscountMinor:  100,
+			TaxMinor:       581,
+		}},
+		SubtotalMinor:   3000,
+		DiscountMinor:   100,
+		Shipment:        model.Shipment{CostMinor: 500},
+		TaxMinor:        581,
+		TotalPriceMinor: 3981,
+		Status:          model.OrderStatusPendingPayment,
+		CreatedAt:       now,
+		PaymentDeadline: now.Add(time.Hour),
+	}
+}
@@ -0,0 +1,85 @@
+package order
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/pricing"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+	"github.com/qyrlabs/test-backend/order/internal/statemachine"
+)
+
+var _ def.OrderService = &service{}
+
+type service struct {
+	orderRepository     repository.OrderRepository
+	promoCodeRepository repository.PromoCodeRepository
+	inventoryClient     grpc.InventoryClient
+	paymentClient       grpc.PaymentClient
+	statusStream        def.StatusStreamService
+	machine             *statemachine.Machine
+	pricing             *pricing.Pipeline
+	// Destination of orders placed without a shipping country.
+	defaultCountry string
+	// Replaced as a whole by Reconfigure.
+	settings atomic.Pointer[model.OrderSettings]
+}
+
+func NewService(orderRepository repository.OrderRepository, promoCodeRepository repository.PromoCodeRepository, inventoryClient grpc.InventoryClient, paymentClient grpc.PaymentClient, statusStream def.StatusStreamService, pricingRules model.PricingRules, settings model.OrderSettings) *service {
+	s := &service{
+		orderRepository:     orderRepository,
+		promoCodeRepository: promoCodeRepository,
+		inventoryClient:     inventoryClient,
+		paymentClient:       paymentClient,
+		statusStream:        statusStream,
+		machine:             NewStateMachine(),
+		pricing:             NewPricingPipeline(pricingRules, inventoryClient),
+		defaultCountry:      pricingRules.DefaultCountry,
+	}
+	s.settings.Store(&settings)
+	s.machine.Before(model.OrderEventPay, s.claimPayment)
+	s.machine.Before(model.OrderEventPay, s.chargeTender)
+	s.machine.Before(model.OrderEventPartialPay, s.claimPayment)
+	s.machine.Before(model.OrderEventPartialPay, s.chargeTender)
+	s.machine.Before(model.OrderEventPayTender, s.chargeTender)
+	s.machine.Before(model.OrderEventCompletePayment, s.chargeTender)
+	s.machine.Before(model.OrderEventResumePayment, s.claimPayment)
+	s.machine.Before(model.OrderEventRevertPayment, s.refundTenders)
+	s.machine.Before(model.OrderEventPartialRefund, s.reserveRefund)
+	s.machine.Before(model.OrderEventPartialRefund, s.refundPayment)
+	s.machine.Before(model.OrderEventRefund, s.reserveRefund)
+	s.machine.Before(model.OrderEventRefund, s.refundPayment)
+	s.machine.After(model.OrderEventPay, raise(model.WebhookEventTypeOrderPaid))
+	s.machine.After(model.OrderEventCompletePayment, raise(model.WebhookEventTypeOrderPaid))
+	s.machine.After(model.OrderEventEdit, raise(model.WebhookEventTypeOrderUpdated))
+	s.machine.After(model.OrderEventCancel, raise(model.WebhookEventTypeOrderCancelled))
+	s.machine.After(model.OrderEventExpire, raise(model.WebhookEventTypeOrderCancelled))
+	s.machine.After(model.OrderEventPartialRefund, raise(model.WebhookEventTypeOrderRefunded))
+	s.machine.After(model.OrderEventRefund, raise(model.WebhookEventTypeOrderRefunded))
+	return s
+}
+
+func (s *service) Reconfigure(settings model.OrderSettings) {
+	s.settings.Store(&settings)
+}
+
+// checkVersion returns ErrVersionMismatch unless ifMatch is nil or contains
+// the version of the order.
+func checkVersion(order *model.Order, ifMatch []int64) error {
+	if ifMatch == nil || slices.Contains(ifMatch, order.Version) {
+		return nil
+	}
+	return fmt.Errorf("%w: order %s is at version %d", model.ErrVersionMismatch, order.OrderUuid, order.Version)
+}
+
+// publish streams the status transitions the order made after the first seen
+// ones. It is called once the order is saved.
+func (s *service) publish(order *model.Order, seen int) {
+	if len(order.History) > seen {
+		s.statusStream.Publish(order.OrderUuid, order.UserUuid, order.History[seen:]...)
+	}
+}
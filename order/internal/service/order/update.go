@@ -0,0 +1,68 @@
+package order
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+func (s *service) Update(ctx context.Context, uuid string, req model.OrderUpdateRequest, ifMatch []int64) (*model.Order, error) {
+	order, err := s.orderRepository.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(order, ifMatch); err != nil {
+		return nil, err
+	}
+
+	seen := len(order.History)
+	err = s.machine.Fire(ctx, order, model.OrderEventEdit, model.UserActor(order.UserUuid), "edited by user")
+	if err != nil {
+		return nil, err
+	}
+
+	items := editItems(order.Items, req.Items)
+	if len(items) == 0 {
+		return nil, model.ErrEmptyOrder
+	}
+
+	// The promo code was redeemed when the order was placed, so it is checked
+	// as of then and keeps applying after it expires.
+	if err := s.price(ctx, order, items, order.PromoCode, order.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := s.orderRepository.Update(ctx, order); err != nil {
+		return nil, err
+	}
+	s.publish(order, seen)
+
+	return order, nil
+}
+
+// editItems applies the edits to the ordered lines, keeping the order of lines
+// and appending new parts. A later edit of the same part wins.
+func editItems(lines []model.OrderItem, edits []model.OrderItemRequest) []model.OrderItemRequest {
+	items := make([]model.OrderItemRequest, 0, len(lines)+len(edits))
+	positions := make(map[string]int, len(lines)+len(edits))
+	for _, line := range lines {
+		positions[line.PartUuid] = len(items)
+		items = append(items, model.OrderItemRequest{PartUuid: line.PartUuid, Quantity: line.Quantity})
+	}
+	for _, edit := range edits {
+		if i, ok := positions[edit.PartUuid]; ok {
+			items[i].Quantity = edit.Quantity
+			continue
+		}
+		positions[edit.PartUuid] = len(items)
+		items = append(items, edit)
+	}
+
+	kept := items[:0]
+	for _, item := range items {
+		if item.Quantity > 0 {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
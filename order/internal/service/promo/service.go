@@ -0,0 +1,81 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+var _ def.PromoCodeService = &service{}
+
+type service struct {
+	promoCodeRepository repository.PromoCodeRepository
+}
+
+func NewService(promoCodeRepository repository.PromoCodeRepository) *service {
+	return &service{
+		promoCodeRepository: promoCodeRepository,
+	}
+}
+
+func (s *service) Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	promo.Code = strings.ToUpper(promo.Code)
+	promo.CreatedAt = time.Now()
+	if promo.ValidFrom.IsZero() {
+		promo.ValidFrom = promo.CreatedAt
+	}
+
+	if err := validate(promo); err != nil {
+		return nil, err
+	}
+
+	if err := s.promoCodeRepository.Create(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (s *service) Get(ctx context.Context, code string) (*model.PromoCode, error) {
+	return s.promoCodeRepository.Get(ctx, strings.ToUpper(code))
+}
+
+func (s *service) List(ctx context.Context) ([]*model.PromoCode, error) {
+	return s.promoCodeRepository.List(ctx)
+}
+
+func (s *service) Delete(ctx context.Context, code string) error {
+	return s.promoCodeRepository.Delete(ctx, strings.ToUpper(code))
+}
+
+func validate(promo *model.PromoCode) error {
+	switch promo.Kind {
+	case model.PromoCodeKindPercentage:
+		if promo.PercentOff < 1 || promo.PercentOff > 100 {
+			return fmt.Errorf("%w: percent_off must be between 1 and 100", model.ErrInvalidPromoCode)
+		}
+		if promo.AmountOffMinor != 0 {
+			return fmt.Errorf("%w: amount_off_minor is not allowed for a percentage code", model.ErrInvalidPromoCode)
+		}
+	case model.PromoCodeKindFixedAmount:
+		if promo.AmountOffMinor < 1 {
+			return fmt.Errorf("%w: amount_off_minor must be positive", model.ErrInvalidPromoCode)
+		}
+		if promo.PercentOff != 0 {
+			return fmt.Errorf("%w: percent_off is not allowed for a fixed amount code", model.ErrInvalidPromoCode)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind", model.ErrInvalidPromoCode)
+	}
+
+	if promo.ValidUntil != nil && !promo.ValidUntil.After(promo.ValidFrom) {
+		return fmt.Errorf("%w: valid_until must be after valid_from", model.ErrInvalidPromoCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package report
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/client/grpc"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+var _ def.ReportService = &service{}
+
+// Orders are read pageSize at a time.
+const pageSize = 500
+
+type service struct {
+	orderRepository repository.OrderRepository
+	inventoryClient grpc.InventoryClient
+}
+
+func NewService(orderRepository repository.OrderRepository, inventoryClient grpc.InventoryClient) *service {
+	return &service{
+		orderRepository: orderRepository,
+		inventoryClient: inventoryClient,
+	}
+}
+
+// groupKey holds the dimensions of a row the report is grouped by, the others are zero.
+type groupKey struct {
+	day           time.Time
+	paymentMethod model.PaymentMethod
+	category      model.PartCategory
+	manufacturer  string
+}
+
+type group struct {
+	row model.SalesRow
+	// Last order counted in the row, lines of an order are added one after another.
+	lastOrderUuid string
+}
+
+func (s *service) Sales(ctx context.Context, query model.SalesQuery) (*model.SalesReport, error) {
+	if err := validate(query); err != nil {
+		return nil, err
+	}
+
+	report := &model.SalesReport{Query: query}
+	groups := make(map[groupKey]*group)
+	parts := make(map[string]*model.Part)
+
+	ordersQuery := model.OrdersQuery{
+		Filter: model.OrdersFilter{
+			CreatedFrom: &query.From,
+			CreatedTo:   &query.To,
+		},
+		Limit: pageSize,
+	}
+	for {
+		page, err := s.orderRepository.List(ctx, ordersQuery)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.loadParts(ctx, page.Orders, parts); err != nil {
+			return nil, err
+		}
+
+		for _, order := range page.Orders {
+			report.OrdersCreated++
+			if order.PaidAt == nil {
+				continue
+			}
+			report.OrdersPaid++
+
+			for _, item := range order.Items {
+				key := keyOf(query.GroupBy, order, parts[item.PartUuid])
+				g, ok := groups[key]
+				if !ok {
+					g = &group{row: rowOf(key)}
+					groups[key] = g
+				}
+				g.add(order.OrderUuid, item)
+			}
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+		ordersQuery.Cursor = page.NextCursor
+	}
+
+	report.Rows = make([]model.SalesRow, 0, len(groups))
+	for _, g := range groups {
+		report.Rows = append(report.Rows, g.row)
+	}
+	slices.SortFunc(report.Rows, func(a, b model.SalesRow) int {
+		return compareRows(query.GroupBy, a, b)
+	})
+
+	return report, nil
+}
+
+// loadParts adds the parts of the paid orders not yet in parts. Parts no
+// longer in inventory are added as nil, so that they are not requested again.
+func (s *service) loadParts(ctx context.Context, orders []*model.Order, parts map[string]*model.Part) error {
+	var uuids []string
+	for _, order := range orders {
+		if order.PaidAt == nil {
+			continue
+		}
+		for _, item := range order.Items {
+			if _, ok := parts[item.PartUuid]; !ok {
+				parts[item.PartUuid] = nil
+				uuids = append(uuids, item.PartUuid)
+			}
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	found, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return fmt.Errorf("%w: failed to get filtered parts: %w", model.ErrUpstream, err)
+	}
+	for _, part := range found {
+		parts[part.Uuid] = part
+	}
+	return nil
+}
+
+func (g *group) add(orderUuid string, item model.OrderItem) {
+	if g.lastOrderUuid != orderUuid {
+		g.lastOrderUuid = orderUuid
+		g.row.OrdersPaid++
+	}
+
+	revenue := item.LineTotalMinor - item.DiscountMinor
+	g.row.Units += item.Quantity
+	g.row.RevenueMinor += revenue
+	if item.Quantity > 0 {
+		g.row.RefundedMinor += revenue * item.RefundedQuantity / item.Quantity
+	}
+}
+
+// keyOf returns the group of a line of the order with the part, nil if the
+// part is no longer in inventory.
+func keyOf(groupBy []model.SalesDimension, order *model.Order, part *model.Part) groupKey {
+	var key groupKey
+	for _, dimension := range groupBy {
+		switch dimension {
+		case model.SalesDimensionDay:
+			y, m, d := order.CreatedAt.UTC().Date()
+			key.day = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		case model.SalesDimensionPaymentMethod:
+			key.paymentMethod = order.PaymentMethod
+		case model.SalesDimensionCategory:
+			if part != nil {
+				key.category = part.Category
+			}
+		case model.SalesDimensionManufacturer:
+			if part != nil {
+				key.manufacturer = part.Manufacturer
+			}
+		}
+	}
+	return key
+}
+
+func rowOf(key groupKey) model.SalesRow {
+	return model.SalesRow{
+		Day:           key.day,
+		PaymentMethod: key.paymentMethod,
+		Category:      key.category,
+		Manufacturer:  key.manufacturer,
+	}
+}
+
+// compareRows orders rows by the dimensions in groupBy in turn.
+func compareRows(groupBy []model.SalesDimension, a, b model.SalesRow) int {
+	for _, dimension := range groupBy {
+		var c int
+		switch dimension {
+		case model.SalesDimensionDay:
+			c = a.Day.Compare(b.Day)
+		case model.SalesDimensionPaymentMethod:
+			c = cmp.Compare(a.PaymentMethod, b.PaymentMethod)
+		case model.SalesDimensionCategory:
+			c = cmp.Compare(a.Category, b.Category)
+		case model.SalesDimensionManufacturer:
+			c = strings.Compare(a.Manufacturer, b.Manufacturer)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func validate(query model.SalesQuery) error {
+	if query.From.IsZero() || query.To.IsZero() {
+		return fmt.Errorf("%w: period start and end are required", model.ErrInvalidSalesQuery)
+	}
+	if !query.From.Before(query.To) {
+		return fmt.Errorf("%w: period must start before it ends", model.ErrInvalidSalesQuery)
+	}
+
+	for i, dimension := range query.GroupBy {
+		if dimension < model.SalesDimensionDay || dimension > model.SalesDimensionManufacturer {
+			return fmt.Errorf("%w: unknown dimension %s", model.ErrInvalidSalesQuery, dimension)
+		}
+		if slices.Contains(query.GroupBy[:i], dimension) {
+			return fmt.Errorf("%w: dimension %s repeated", model.ErrInvalidSalesQuery, dimension)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// OrderService manages orders. Pay, Cancel and Update take the order versions
+// the client expects in ifMatch and fail with ErrVersionMismatch if the order
+// is at another version, a nil ifMatch accepts any version. They fail with
+// ErrOrderChanged if the order changes while they run.
+type OrderService interface {
+	// Create places and prices an order.
+	Create(ctx context.Context, req model.OrderRequest) (*model.Order, error)
+	Get(ctx context.Context, uuid string) (*model.Order, error)
+	// Update edits the lines of an order pending payment and reprices it.
+	Update(ctx context.Context, uuid string, req model.OrderUpdateRequest, ifMatch []int64) (*model.Order, error)
+	List(ctx context.Context, query model.OrdersQuery) (*model.OrdersPage, error)
+	// Pay pays the order with one payment method or with tenders charged one
+	// by one, see model.PayRequest. If a tender fails, the charged ones are
+	// refunded. If the ordered parts changed in inventory, it may fail with
+	// model.ItemsChangedError depending on the price change policy.
+	Pay(ctx context.Context, uuid string, req model.PayRequest, ifMatch []int64) (*model.Order, error)
+	Cancel(ctx context.Context, uuid string, ifMatch []int64) (*model.Order, error)
+	// Refund refunds a paid order fully or partially, see model.RefundRequest.
+	Refund(ctx context.Context, uuid string, req model.RefundRequest, ifMatch []int64) (*model.Order, error)
+	// ExpandParts reads the parts of the orders from inventory in one request.
+	// If inventory fails, the expansion is partial instead of an error.
+	ExpandParts(ctx context.Context, orders []*model.Order) *model.PartsExpansion
+	// History returns status transitions of the order in chronological order.
+	History(ctx context.Context, uuid string) ([]model.StatusTransition, error)
+	// Expire cancels up to limit orders not paid before their payment deadline
+	// and returns their number.
+	Expire(ctx context.Context, limit int) (int, error)
+	// Reconfigure replaces the settings of orders, see model.OrderSettings.
+	Reconfigure(settings model.OrderSettings)
+}
+
+// ReportService builds sales reports from stored orders.
+type ReportService interface {
+	// Sales aggregates the orders created in the period of the query. Category
+	// and manufacturer of the ordered parts are taken from inventory.
+	Sales(ctx context.Context, query model.SalesQuery) (*model.SalesReport, error)
+}
+
+type PromoCodeService interface {
+	Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error)
+	Get(ctx context.Context, code string) (*model.PromoCode, error)
+	List(ctx context.Context) ([]*model.PromoCode, error)
+	Delete(ctx context.Context, code string) error
+}
+
+type WebhookService interface {
+	// Create subscribes the webhook to events and generates its signing secret.
+	Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	Get(ctx context.Context, uuid string) (*model.Webhook, error)
+	List(ctx context.Context) ([]*model.Webhook, error)
+	Delete(ctx context.Context, uuid string) error
+	// ListDeadLetters returns deliveries given up after all attempts, most recent first.
+	ListDeadLetters(ctx context.Context) ([]*model.WebhookDelivery, error)
+	// Dispatch moves up to limit events from the outbox to deliveries of the
+	// subscribed webhooks and returns the number of events.
+	Dispatch(ctx context.Context, limit int) (int, error)
+	// Deliver sends up to limit due deliveries and returns the number of
+	// successful ones. Failed deliveries are retried with exponential backoff.
+	Deliver(ctx context.Context, limit int) (int, error)
+}
+
+type StatusStreamService interface {
+	// Publish sends the status transitions of the order to matching subscribers.
+	Publish(orderUuid, userUuid string, transitions ...model.StatusTransition)
+	// Subscribe returns matching changes published from now on and the ID of
+	// the latest change. The channel is closed when ctx is done, when the
+	// subscriber falls too far behind, or on Close.
+	Subscribe(ctx context.Context, filter model.StatusChangeFilter) (<-chan model.StatusChange, uint64)
+	// Resume is Subscribe that first returns matching changes published after
+	// lastID which are still buffered.
+	Resume(ctx context.Context, filter model.StatusChangeFilter, lastID uint64) (<-chan model.StatusChange, uint64)
+	// Close ends all subscriptions, used on shutdown.
+	Close()
+}
+
+type IdempotencyService interface {
+	// Begin reserves the key for a request with the given fingerprint. It returns
+	// the stored response of a completed request, or nil if the caller should
+	// handle the request and then call Complete or Abort.
+	Begin(ctx context.Context, key, fingerprint string) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, key string, response model.IdempotentResponse) error
+	// Abort releases the key so that the request can be retried.
+	Abort(ctx context.Context, key string) error
+	// Cleanup removes expired keys and returns their number.
+	Cleanup(ctx context.Context) (int, error)
+}
@@ -0,0 +1,136 @@
+package stream
+
+import (
+	"context"
+	"sync"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+var _ def.StatusStreamService = &service{}
+
+// subscriberBuffer is the number of changes a subscriber may lag behind before
+// it is disconnected. A disconnected client resumes from the replay buffer.
+const subscriberBuffer = 64
+
+type subscriber struct {
+	filter  model.StatusChangeFilter
+	changes chan model.StatusChange
+}
+
+// service fans status changes out to subscribers and keeps the latest ones so
+// that reconnecting clients can resume.
+type service struct {
+	mu     sync.Mutex
+	lastID uint64
+	// Latest changes, oldest first, at most replaySize of them.
+	replay      []model.StatusChange
+	replaySize  int
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+func NewService(replaySize int) *service {
+	return &service{
+		replay:      make([]model.StatusChange, 0, replaySize),
+		replaySize:  replaySize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (s *service) Publish(orderUuid, userUuid string, transitions ...model.StatusTransition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, transition := range transitions {
+		s.lastID++
+		change := model.StatusChange{
+			ID:         s.lastID,
+			OrderUuid:  orderUuid,
+			UserUuid:   userUuid,
+			Transition: transition,
+		}
+
+		if len(s.replay) == s.replaySize {
+			s.replay = append(s.replay[:0], s.replay[1:]...)
+		}
+		s.replay = append(s.replay, change)
+
+		for sub := range s.subscribers {
+			if !sub.filter.Matches(change) {
+				continue
+			}
+			select {
+			case sub.changes <- change:
+			default:
+				// Too slow, the client reconnects and resumes from the replay buffer.
+				s.unsubscribe(sub)
+			}
+		}
+	}
+}
+
+func (s *service) Subscribe(ctx context.Context, filter model.StatusChangeFilter) (<-chan model.StatusChange, uint64) {
+	return s.subscribe(ctx, filter, nil)
+}
+
+func (s *service) Resume(ctx context.Context, filter model.StatusChangeFilter, lastID uint64) (<-chan model.StatusChange, uint64) {
+	return s.subscribe(ctx, filter, &lastID)
+}
+
+// subscribe registers a subscriber, first sending it the buffered changes after
+// lastID unless lastID is nil.
+func (s *service) subscribe(ctx context.Context, filter model.StatusChangeFilter, lastID *uint64) (<-chan model.StatusChange, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	missed := make([]model.StatusChange, 0)
+	if lastID != nil {
+		for _, change := range s.replay {
+			if change.ID > *lastID && filter.Matches(change) {
+				missed = append(missed, change)
+			}
+		}
+	}
+
+	sub := &subscriber{
+		filter:  filter,
+		changes: make(chan model.StatusChange, len(missed)+subscriberBuffer),
+	}
+	for _, change := range missed {
+		sub.changes <- change
+	}
+	if s.closed {
+		close(sub.changes)
+		return sub.changes, s.lastID
+	}
+	s.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribe(sub)
+	}()
+
+	return sub.changes, s.lastID
+}
+
+func (s *service) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for sub := range s.subscribers {
+		s.unsubscribe(sub)
+	}
+}
+
+// unsubscribe closes the subscriber channel once. It must be called with mu held.
+func (s *service) unsubscribe(sub *subscriber) {
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.changes)
+	}
+}
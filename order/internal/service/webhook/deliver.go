@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// maxRetryDelay caps the exponential backoff between attempts.
+const maxRetryDelay = time.Hour
+
+func (s *service) Deliver(ctx context.Context, limit int) (int, error) {
+	deliveries, err := s.webhookRepository.ListDueDeliveries(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		webhook, err := s.webhookRepository.Get(ctx, delivery.WebhookUuid)
+		if errors.Is(err, model.ErrWebhookNotFound) {
+			// Deleted after the deliveries were listed.
+			continue
+		}
+		if err != nil {
+			return delivered, err
+		}
+
+		sendErr := s.webhookClient.Send(ctx, webhook, &delivery.Event)
+		if ctx.Err() != nil {
+			// Interrupted by shutdown, the attempt does not count.
+			return delivered, ctx.Err()
+		}
+		if sendErr != nil {
+			if err := s.webhookRepository.UpdateDelivery(ctx, s.fail(delivery, sendErr)); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		if err := s.webhookRepository.DeleteDelivery(ctx, delivery.Uuid); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+// fail records a failed attempt and schedules the next one after an
+// exponential backoff, or dead-letters the delivery once attempts run out.
+func (s *service) fail(delivery *model.WebhookDelivery, sendErr error) *model.WebhookDelivery {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastError = sendErr.Error()
+
+	if delivery.Attempts >= s.maxAttempts {
+		delivery.DeadAt = &now
+		return delivery
+	}
+
+	delay := s.retryDelay
+	for i := int64(1); i < delivery.Attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	delivery.NextAttemptAt = now.Add(min(delay, maxRetryDelay))
+	return delivery
+}
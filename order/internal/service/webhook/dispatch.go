@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Dispatch turns outbox events into deliveries to the subscribed webhooks.
+// An event is deleted from the outbox only after its deliveries are stored,
+// so it is delivered at least once.
+func (s *service) Dispatch(ctx context.Context, limit int) (int, error) {
+	events, err := s.outboxRepository.ListEvents(ctx, limit)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+
+	webhooks, err := s.webhookRepository.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	deliveries := make([]*model.WebhookDelivery, 0, len(events))
+	eventUuids := make([]string, 0, len(events))
+	for _, event := range events {
+		for _, webhook := range webhooks {
+			if !webhook.Subscribed(event.Type) {
+				continue
+			}
+			deliveries = append(deliveries, &model.WebhookDelivery{
+				Uuid:          uuid.NewString(),
+				WebhookUuid:   webhook.Uuid,
+				Event:         *event,
+				NextAttemptAt: now,
+			})
+		}
+		eventUuids = append(eventUuids, event.Uuid)
+	}
+
+	if err := s.webhookRepository.AddDeliveries(ctx, deliveries); err != nil {
+		return 0, err
+	}
+	if err := s.outboxRepository.DeleteEvents(ctx, eventUuids); err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	httpClient "github.com/qyrlabs/test-backend/order/internal/client/http"
+	"github.com/qyrlabs/test-backend/order/internal/model"
+	"github.com/qyrlabs/test-backend/order/internal/repository"
+	def "github.com/qyrlabs/test-backend/order/internal/service"
+)
+
+var _ def.WebhookService = &service{}
+
+// secretSize is the number of random bytes of a webhook secret.
+const secretSize = 32
+
+type service struct {
+	webhookRepository repository.WebhookRepository
+	outboxRepository  repository.OutboxRepository
+	webhookClient     httpClient.WebhookClient
+	// Attempts of a delivery before it is dead-lettered.
+	maxAttempts int64
+	// Delay before the first retry, doubled for every next one.
+	retryDelay time.Duration
+}
+
+func NewService(webhookRepository repository.WebhookRepository, outboxRepository repository.OutboxRepository, webhookClient httpClient.WebhookClient, maxAttempts int64, retryDelay time.Duration) *service {
+	return &service{
+		webhookRepository: webhookRepository,
+		outboxRepository:  outboxRepository,
+		webhookClient:     webhookClient,
+		maxAttempts:       maxAttempts,
+		retryDelay:        retryDelay,
+	}
+}
+
+func (s *service) Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	if err := validate(webhook); err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	webhook.Uuid = uuid.NewString()
+	webhook.Secret = hex.EncodeToString(secret)
+	webhook.CreatedAt = time.Now()
+
+	if err := s.webhookRepository.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *service) Get(ctx context.Context, uuid string) (*model.Webhook, error) {
+	return s.webhookRepository.Get(ctx, uuid)
+}
+
+func (s *service) List(ctx context.Context) ([]*model.Webhook, error) {
+	return s.webhookRepository.List(ctx)
+}
+
+func (s *service) Delete(ctx context.Context, uuid string) error {
+	return s.webhookRepository.Delete(ctx, uuid)
+}
+
+func (s *service) ListDeadLetters(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	return s.webhookRepository.ListDeadLetters(ctx)
+}
+
+func validate(webhook *model.Webhook) error {
+	endpoint, err := url.Parse(webhook.Url)
+	if err != nil {
+		return fmt.Errorf("%w: %v", model.ErrInvalidWebhook, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return fmt.Errorf("%w: url must use http or https", model.ErrInvalidWebhook)
+	}
+	if endpoint.Host == "" {
+		return fmt.Errorf("%w: url must have a host", model.ErrInvalidWebhook)
+	}
+
+	for _, eventType := range webhook.EventTypes {
+		if eventType == model.WebhookEventTypeUnspecified {
+			return fmt.Errorf("%w: unknown event type", model.ErrInvalidWebhook)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package statemachine
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// WriteDOT writes the transition table as a Graphviz digraph.
+// Statuses without outgoing transitions are drawn as final.
+func (m *Machine) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("digraph order_status {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	b.WriteString("\tstart [shape=point];\n")
+	fmt.Fprintf(&b, "\tstart -> %s;\n", m.initial)
+
+	outgoing := make(map[model.OrderStatus]bool)
+	for _, t := range m.transitions {
+		for _, from := range t.From {
+			outgoing[from] = true
+		}
+	}
+
+	for _, status := range m.statuses() {
+		if !outgoing[status] {
+			fmt.Fprintf(&b, "\t%s [peripheries=2];\n", status)
+		}
+	}
+
+	for _, t := range m.transitions {
+		label := string(t.Event)
+		if len(t.Guards) > 0 {
+			label += " [guarded]"
+		}
+		for _, from := range t.From {
+			fmt.Fprintf(&b, "\t%s -> %s [label=%q];\n", from, t.To, label)
+		}
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// statuses returns every status of the table in order of appearance.
+func (m *Machine) statuses() []model.OrderStatus {
+	seen := map[model.OrderStatus]bool{m.initial: true}
+	statuses := []model.OrderStatus{m.initial}
+	add := func(status model.OrderStatus) {
+		if !seen[status] {
+			seen[status] = true
+			statuses = append(statuses, status)
+		}
+	}
+	for _, t := range m.transitions {
+		for _, from := range t.From {
+			add(from)
+		}
+		add(t.To)
+	}
+	return statuses
+}
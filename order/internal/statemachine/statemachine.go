@@ -0,0 +1,119 @@
+// Package statemachine drives order status changes through a declarative
+// table of transitions with guards and side-effect hooks.
+package statemachine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/qyrlabs/test-backend/order/internal/model"
+)
+
+// Guard rejects a transition by returning an error. Guards must not have side effects.
+type Guard func(ctx context.Context, order *model.Order) error
+
+// Hook is a side effect of a transition.
+type Hook func(ctx context.Context, order *model.Order, transition model.StatusTransition) error
+
+// Transition moves an order from any of From statuses to To on Event.
+type Transition struct {
+	Event  model.OrderEvent
+	From   []model.OrderStatus
+	To     model.OrderStatus
+	Guards []Guard
+}
+
+type Machine struct {
+	initial     model.OrderStatus
+	transitions []Transition
+	// Hooks by event. Before hooks run after guards and abort the transition
+	// on error, after hooks run once the status has changed.
+	before map[model.OrderEvent][]Hook
+	after  map[model.OrderEvent][]Hook
+}
+
+// New creates a state machine that starts orders in initial status.
+func New(initial model.OrderStatus, transitions ...Transition) *Machine {
+	return &Machine{
+		initial:     initial,
+		transitions: transitions,
+		before:      make(map[model.OrderEvent][]Hook),
+		after:       make(map[model.OrderEvent][]Hook),
+	}
+}
+
+// Before registers a hook run before the status changes on event.
+func (m *Machine) Before(event model.OrderEvent, hook Hook) {
+	m.before[event] = append(m.before[event], hook)
+}
+
+// After registers a hook run after the status has changed on event.
+func (m *Machine) After(event model.OrderEvent, hook Hook) {
+	m.after[event] = append(m.after[event], hook)
+}
+
+// Start puts a new order into the initial status.
+func (m *Machine) Start(order *model.Order, at time.Time, actor, reason string) {
+	order.SetStatus(m.initial, at, actor, reason)
+}
+
+// Can reports whether event is allowed in status.
+func (m *Machine) Can(status model.OrderStatus, event model.OrderEvent) bool {
+	_, ok := m.find(status, event)
+	return ok
+}
+
+// Fire applies event to the order. Disallowed events fail with
+// model.ErrTransitionNotAllowed, guard and hook errors are returned as is.
+// The order is only modified in memory, persisting it is up to the caller.
+func (m *Machine) Fire(ctx context.Context, order *model.Order, event model.OrderEvent, actor, reason string) error {
+	t, ok := m.find(order.Status, event)
+	if !ok {
+		return fmt.Errorf("%w: cannot %s order in status %s", model.ErrTransitionNotAllowed, event, order.Status)
+	}
+
+	for _, guard := range t.Guards {
+		if err := guard(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	transition := model.StatusTransition{
+		From:   order.Status,
+		To:     t.To,
+		At:     time.Now(),
+		Actor:  actor,
+		Reason: reason,
+	}
+
+	for _, hook := range m.before[event] {
+		if err := hook(ctx, order, transition); err != nil {
+			return err
+		}
+	}
+
+	order.SetStatus(transition.To, transition.At, transition.Actor, transition.Reason)
+
+	for _, hook := range m.after[event] {
+		if err := hook(ctx, order, transition); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Machine) find(status model.OrderStatus, event model.OrderEvent) (Transition, bool) {
+	for _, t := range m.transitions {
+		if t.Event != event {
+			continue
+		}
+		for _, from := range t.From {
+			if from == status {
+				return t, true
+			}
+		}
+	}
+	return Transition{}, false
+}
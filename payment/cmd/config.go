@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
+)
+
+// Storage backends of payments.
+const storageBackendMemory = "memory"
+
+// Config of the payment service, loaded by config.Loader.
+type Config struct {
+	GRPC struct {
+		Addr string `yaml:"addr" usage:"address the gRPC server listens on"`
+	} `yaml:"grpc"`
+	Gateway struct {
+		Addr string `yaml:"addr" usage:"address of HTTP/JSON gateway, disabled if empty"`
+	} `yaml:"gateway"`
+	Storage struct {
+		Backend string `yaml:"backend" usage:"storage of payments, only memory is supported"`
+	} `yaml:"storage"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" usage:"how long open calls are waited for on shutdown" reload:"true"`
+}
+
+func defaultConfig() *Config {
+	cfg := &Config{}
+	cfg.GRPC.Addr = "localhost:50062"
+	cfg.Storage.Backend = storageBackendMemory
+	cfg.ShutdownTimeout = 10 * time.Second
+	return cfg
+}
+
+func (c *Config) Validate() error {
+	errs := []error{
+		config.ValidateAddr("grpc.addr", c.GRPC.Addr),
+		config.ValidatePositive("shutdown_timeout", c.ShutdownTimeout),
+	}
+	if c.Gateway.Addr != "" {
+		errs = append(errs, config.ValidateAddr("gateway.addr", c.Gateway.Addr))
+	}
+	if c.Storage.Backend != storageBackendMemory {
+		errs = append(errs, fmt.Errorf("unknown storage.backend %q, want %s", c.Storage.Backend, storageBackendMemory))
+	}
+	return errors.Join(errs...)
+}
@@ -2,49 +2,57 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 
-	"github.com/google/uuid"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
 
+	apipaymentv1 "github.com/qyrlabs/test-backend/payment/internal/api/payment/v1"
+	paymentRepository "github.com/qyrlabs/test-backend/payment/internal/repository/payment"
+	paymentService "github.com/qyrlabs/test-backend/payment/internal/service/payment"
+	"github.com/qyrlabs/test-backend/shared/pkg/config"
+	"github.com/qyrlabs/test-backend/shared/pkg/gateway"
 	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
 )
 
-const grpcPort = 50062
-
-type paymentService struct {
-	paymentv1.UnimplementedPaymentServiceServer
-}
+func main() {
+	loader := config.NewLoader("PAYMENT", defaultConfig)
+	loader.RegisterFlags(flag.CommandLine)
+	flag.Parse()
 
-// Initiates order payment.
-func (s *paymentService) PayOrder(ctx context.Context, req *paymentv1.PayOrderRequest) (*paymentv1.PayOrderResponse, error) {
-	if _, err := uuid.Parse(req.GetOrderUuid()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid order_uuid format: %v", err)
+	cfg, err := loader.Load()
+	if err != nil {
+		log.Printf("failed to load config: %v\n", err)
+		return
 	}
-	if _, err := uuid.Parse(req.GetUserUuid()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	effective, err := config.Format(cfg)
+	if err != nil {
+		log.Printf("%v\n", err)
+		return
 	}
-	if req.GetPaymentMethod() == 0 {
-		return nil, status.Error(codes.InvalidArgument, "invalid payment method")
+	if loader.PrintOnly() {
+		fmt.Print(effective)
+		return
 	}
+	log.Printf("effective config:\n%s", effective)
 
-	uuid := uuid.New().String()
-	log.Printf("Payment succeed, transaction_uuid: %s", uuid)
-	return &paymentv1.PayOrderResponse{
-		TransactionUuid: uuid,
-	}, nil
-}
+	// Settings safe to change at runtime are read from current and reloaded on SIGHUP.
+	var current atomic.Pointer[Config]
+	current.Store(cfg)
+	reloadCtx, stopReload := context.WithCancel(context.Background())
+	defer stopReload()
+	go loader.Watch(reloadCtx, cfg, current.Store)
 
-func main() {
-	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", grpcPort))
+	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
 		return
@@ -53,9 +61,12 @@ func main() {
 	grpcServer := grpc.NewServer()
 	reflection.Register(grpcServer)
 
-	service := &paymentService{}
+	// Memory is the only storage backend, see Config.Storage.
+	repo := paymentRepository.NewRepository()
+	service := paymentService.NewService(repo)
+	api := apipaymentv1.NewAPI(service)
 
-	paymentv1.RegisterPaymentServiceServer(grpcServer, service)
+	paymentv1.RegisterPaymentServiceServer(grpcServer, api)
 
 	go func() {
 		log.Printf("gRPC server listening on %s\n", lis.Addr().String())
@@ -66,10 +77,37 @@ func main() {
 		}
 	}()
 
+	var gatewayServer *http.Server
+	if cfg.Gateway.Addr != "" {
+		gatewayServer, err = gateway.NewServer(context.Background(), cfg.Gateway.Addr, lis.Addr().String(), paymentv1.RegisterPaymentServiceHandlerFromEndpoint)
+		if err != nil {
+			log.Printf("failed to create HTTP gateway: %v\n", err)
+			return
+		}
+
+		go func() {
+			log.Printf("HTTP gateway listening on %s\n", gatewayServer.Addr)
+			err := gatewayServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("failed to serve HTTP gateway: %v\n", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
+
+	if gatewayServer != nil {
+		log.Println("Shutting down HTTP gateway...")
+		ctx, cancel := context.WithTimeout(context.Background(), current.Load().ShutdownTimeout)
+		defer cancel()
+		if err := gatewayServer.Shutdown(ctx); err != nil {
+			log.Printf("failed to shutdown HTTP gateway: %v\n", err)
+		}
+	}
+
 	log.Println("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
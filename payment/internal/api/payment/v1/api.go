@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/qyrlabs/test-backend/payment/internal/service"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+type api struct {
+	paymentv1.UnimplementedPaymentServiceServer
+
+	paymentService service.PaymentService
+}
+
+func NewAPI(paymentService service.PaymentService) *api {
+	return &api{
+		paymentService: paymentService,
+	}
+}
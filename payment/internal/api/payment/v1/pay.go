@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/payment/internal/converter"
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+// Initiates order payment.
+func (a *api) PayOrder(ctx context.Context, req *paymentv1.PayOrderRequest) (*paymentv1.PayOrderResponse, error) {
+	if _, err := uuid.Parse(req.GetOrderUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order_uuid format: %v", err)
+	}
+	if _, err := uuid.Parse(req.GetUserUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+	if req.GetTenderUuid() != "" {
+		if _, err := uuid.Parse(req.GetTenderUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tender_uuid format: %v", err)
+		}
+	}
+	paymentMethod := converter.ToModelPaymentMethod(req.GetPaymentMethod())
+	if paymentMethod == model.PaymentMethodUnspecified {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment method")
+	}
+
+	if req.GetAmountMinor() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	payment, err := a.paymentService.Pay(ctx, req.GetOrderUuid(), req.GetTenderUuid(), req.GetUserUuid(), paymentMethod, req.GetAmountMinor())
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentMismatch) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		log.Printf("failed to pay order %s: %v", req.GetOrderUuid(), err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.PayOrderResponse{
+		TransactionUuid: payment.TransactionUuid,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+// Refunds a payment fully or partially.
+func (a *api) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.RefundPaymentResponse, error) {
+	if _, err := uuid.Parse(req.GetTransactionUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction_uuid format: %v", err)
+	}
+	if req.GetRefundUuid() != "" {
+		if _, err := uuid.Parse(req.GetRefundUuid()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid refund_uuid format: %v", err)
+		}
+	}
+	if req.GetAmountMinor() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	payment, refund, err := a.paymentService.Refund(ctx, req.GetTransactionUuid(), req.GetRefundUuid(), req.GetAmountMinor(), req.GetReason())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPaymentNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrRefundExceedsPayment):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, model.ErrRefundMismatch):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		log.Printf("failed to refund payment %s: %v", req.GetTransactionUuid(), err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.RefundPaymentResponse{
+		RefundTransactionUuid: refund.TransactionUuid,
+		RefundedTotalMinor:    payment.RefundedMinor(),
+	}, nil
+}
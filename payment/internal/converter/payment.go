@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+	paymentv1 "github.com/qyrlabs/test-backend/shared/pkg/proto/payment/v1"
+)
+
+func ToModelPaymentMethod(method paymentv1.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCard
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSbp
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCreditCard
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
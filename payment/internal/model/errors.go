@@ -0,0 +1,15 @@
+package model
+
+import "errors"
+
+var (
+	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrPaymentAlreadyExists = errors.New("order already paid")
+	ErrRefundExceedsPayment = errors.New("refund exceeds paid amount")
+	// ErrPaymentMismatch is returned when an order or its tender is paid again with different parameters.
+	ErrPaymentMismatch = errors.New("order already paid with different parameters")
+	// ErrRefundAlreadyExists is returned when a refund with the same refund UUID is already made.
+	ErrRefundAlreadyExists = errors.New("refund already made")
+	// ErrRefundMismatch is returned when a refund is made again with a different amount.
+	ErrRefundMismatch = errors.New("refund already made with different amount")
+)
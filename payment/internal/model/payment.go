@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+type Payment struct {
+	// Unique identifier of the payment transaction.
+	TransactionUuid string
+	// UUID of the paid order.
+	OrderUuid string
+	// UUID of the tender of an order split across several payments, empty
+	// if the payment covers the whole order.
+	TenderUuid string
+	// UUID of the user who paid.
+	UserUuid string
+	// Method used to pay.
+	PaymentMethod PaymentMethod
+	// Paid amount in minor units.
+	AmountMinor int64
+	// Payment timestamp.
+	CreatedAt time.Time
+	// Refunds of the payment in chronological order.
+	Refunds []Refund
+}
+
+// Refund returns money of the Payment.
+type Refund struct {
+	// Unique identifier of the refund transaction.
+	TransactionUuid string
+	// UUID of the refund chosen by the caller, empty if the refund has none.
+	RefundUuid string
+	// Refunded amount in minor units.
+	AmountMinor int64
+	Reason      string
+	CreatedAt   time.Time
+}
+
+// Method used to make the Payment.
+type PaymentMethod int32
+
+const (
+	PaymentMethodUnspecified   PaymentMethod = 0
+	PaymentMethodCard          PaymentMethod = 1
+	PaymentMethodSbp           PaymentMethod = 2
+	PaymentMethodCreditCard    PaymentMethod = 3
+	PaymentMethodInvestorMoney PaymentMethod = 4
+)
+
+// Refund returns the refund of the payment with the refund UUID.
+func (p *Payment) Refund(refundUuid string) (*Refund, bool) {
+	for i := range p.Refunds {
+		if p.Refunds[i].RefundUuid == refundUuid {
+			return &p.Refunds[i], true
+		}
+	}
+	return nil, false
+}
+
+// RefundedMinor returns the amount refunded so far.
+func (p *Payment) RefundedMinor() int64 {
+	var total int64
+	for _, refund := range p.Refunds {
+		total += refund.AmountMinor
+	}
+	return total
+}
@@ -0,0 +1,94 @@
+package converter
+
+import (
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+	"github.com/qyrlabs/test-backend/payment/internal/repository/repomodel"
+)
+
+func ToModelPayment(payment repomodel.Payment) *model.Payment {
+	return &model.Payment{
+		TransactionUuid: payment.TransactionUuid,
+		OrderUuid:       payment.OrderUuid,
+		TenderUuid:      payment.TenderUuid,
+		UserUuid:        payment.UserUuid,
+		PaymentMethod:   ToModelPaymentMethod(payment.PaymentMethod),
+		AmountMinor:     payment.AmountMinor,
+		CreatedAt:       payment.CreatedAt,
+		Refunds:         ToModelRefunds(payment.Refunds),
+	}
+}
+
+func ToModelRefunds(refunds []repomodel.Refund) []model.Refund {
+	modelRefunds := make([]model.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		modelRefunds = append(modelRefunds, model.Refund{
+			TransactionUuid: refund.TransactionUuid,
+			RefundUuid:      refund.RefundUuid,
+			AmountMinor:     refund.AmountMinor,
+			Reason:          refund.Reason,
+			CreatedAt:       refund.CreatedAt,
+		})
+	}
+	return modelRefunds
+}
+
+func ToModelPaymentMethod(method repomodel.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case repomodel.PaymentMethodUnspecified:
+		return model.PaymentMethodUnspecified
+	case repomodel.PaymentMethodCard:
+		return model.PaymentMethodCard
+	case repomodel.PaymentMethodSbp:
+		return model.PaymentMethodSbp
+	case repomodel.PaymentMethodCreditCard:
+		return model.PaymentMethodCreditCard
+	case repomodel.PaymentMethodInvestorMoney:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+func ToRepoPayment(payment *model.Payment) repomodel.Payment {
+	return repomodel.Payment{
+		TransactionUuid: payment.TransactionUuid,
+		OrderUuid:       payment.OrderUuid,
+		TenderUuid:      payment.TenderUuid,
+		UserUuid:        payment.UserUuid,
+		PaymentMethod:   ToRepoPaymentMethod(payment.PaymentMethod),
+		AmountMinor:     payment.AmountMinor,
+		CreatedAt:       payment.CreatedAt,
+		Refunds:         ToRepoRefunds(payment.Refunds),
+	}
+}
+
+func ToRepoRefunds(refunds []model.Refund) []repomodel.Refund {
+	repoRefunds := make([]repomodel.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		repoRefunds = append(repoRefunds, repomodel.Refund{
+			TransactionUuid: refund.TransactionUuid,
+			RefundUuid:      refund.RefundUuid,
+			AmountMinor:     refund.AmountMinor,
+			Reason:          refund.Reason,
+			CreatedAt:       refund.CreatedAt,
+		})
+	}
+	return repoRefunds
+}
+
+func ToRepoPaymentMethod(method model.PaymentMethod) repomodel.PaymentMethod {
+	switch method {
+	case model.PaymentMethodUnspecified:
+		return repomodel.PaymentMethodUnspecified
+	case model.PaymentMethodCard:
+		return repomodel.PaymentMethodCard
+	case model.PaymentMethodSbp:
+		return repomodel.PaymentMethodSbp
+	case model.PaymentMethodCreditCard:
+		return repomodel.PaymentMethodCreditCard
+	case model.PaymentMethodInvestorMoney:
+		return repomodel.PaymentMethodInvestorMoney
+	default:
+		return repomodel.PaymentMethodUnspecified
+	}
+}
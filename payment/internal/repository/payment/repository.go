@@ -0,0 +1,94 @@
+package payment
+
+import (
+	"context"
+	"sync"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+	def "github.com/qyrlabs/test-backend/payment/internal/repository"
+	"github.com/qyrlabs/test-backend/payment/internal/repository/converter"
+	"github.com/qyrlabs/test-backend/payment/internal/repository/repomodel"
+)
+
+var _ def.PaymentRepository = &repository{}
+
+type repository struct {
+	mu sync.RWMutex
+	// Payments by order and tender UUID.
+	payments map[tenderKey]repomodel.Payment
+	// Payment keys by payment transaction UUID.
+	byTransaction map[string]tenderKey
+}
+
+// tenderKey identifies a payment of an order, tenderUuid is empty for a
+// payment of the whole order.
+type tenderKey struct {
+	orderUuid  string
+	tenderUuid string
+}
+
+func NewRepository() *repository {
+	return &repository{
+		payments:      make(map[tenderKey]repomodel.Payment),
+		byTransaction: make(map[string]tenderKey),
+	}
+}
+
+func (r *repository) Create(ctx context.Context, payment *model.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := tenderKey{orderUuid: payment.OrderUuid, tenderUuid: payment.TenderUuid}
+	if _, ok := r.payments[key]; ok {
+		return model.ErrPaymentAlreadyExists
+	}
+	r.payments[key] = converter.ToRepoPayment(payment)
+	r.byTransaction[payment.TransactionUuid] = key
+	return nil
+}
+
+func (r *repository) GetByTender(ctx context.Context, orderUuid, tenderUuid string) (*model.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payment, ok := r.payments[tenderKey{orderUuid: orderUuid, tenderUuid: tenderUuid}]
+	if !ok {
+		return nil, model.ErrPaymentNotFound
+	}
+	return converter.ToModelPayment(payment), nil
+}
+
+func (r *repository) Get(ctx context.Context, transactionUuid string) (*model.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.byTransaction[transactionUuid]
+	if !ok {
+		return nil, model.ErrPaymentNotFound
+	}
+	return converter.ToModelPayment(r.payments[key]), nil
+}
+
+func (r *repository) AddRefund(ctx context.Context, transactionUuid string, refund model.Refund) (*model.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.byTransaction[transactionUuid]
+	if !ok {
+		return nil, model.ErrPaymentNotFound
+	}
+	payment := converter.ToModelPayment(r.payments[key])
+
+	if refund.RefundUuid != "" {
+		if _, ok := payment.Refund(refund.RefundUuid); ok {
+			return nil, model.ErrRefundAlreadyExists
+		}
+	}
+	if payment.RefundedMinor()+refund.AmountMinor > payment.AmountMinor {
+		return nil, model.ErrRefundExceedsPayment
+	}
+
+	payment.Refunds = append(payment.Refunds, refund)
+	r.payments[key] = converter.ToRepoPayment(payment)
+	return payment, nil
+}
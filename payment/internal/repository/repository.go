@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+)
+
+type PaymentRepository interface {
+	// Create stores the payment, or returns ErrPaymentAlreadyExists if the
+	// order, or the tender of the order, is already paid.
+	Create(ctx context.Context, payment *model.Payment) error
+	// GetByTender returns the payment of the order tender, an empty tender
+	// UUID gets the payment of the whole order.
+	GetByTender(ctx context.Context, orderUuid, tenderUuid string) (*model.Payment, error)
+	// Get returns the payment with the transaction UUID.
+	Get(ctx context.Context, transactionUuid string) (*model.Payment, error)
+	// AddRefund appends the refund to the payment with the transaction UUID,
+	// or returns ErrRefundExceedsPayment if refunds would exceed the paid amount
+	// and ErrRefundAlreadyExists if a refund with the same refund UUID is made.
+	AddRefund(ctx context.Context, transactionUuid string, refund model.Refund) (*model.Payment, error)
+}
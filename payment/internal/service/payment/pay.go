@@ -0,0 +1,43 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+)
+
+func (s *service) Pay(ctx context.Context, orderUuid, tenderUuid, userUuid string, paymentMethod model.PaymentMethod, amountMinor int64) (*model.Payment, error) {
+	payment := &model.Payment{
+		TransactionUuid: uuid.NewString(),
+		OrderUuid:       orderUuid,
+		TenderUuid:      tenderUuid,
+		UserUuid:        userUuid,
+		PaymentMethod:   paymentMethod,
+		AmountMinor:     amountMinor,
+		CreatedAt:       time.Now(),
+	}
+
+	err := s.paymentRepository.Create(ctx, payment)
+	if errors.Is(err, model.ErrPaymentAlreadyExists) {
+		// The order or tender is paid once, retries get the original transaction.
+		existing, err := s.paymentRepository.GetByTender(ctx, orderUuid, tenderUuid)
+		if err != nil {
+			return nil, err
+		}
+		if existing.UserUuid != userUuid || existing.PaymentMethod != paymentMethod || existing.AmountMinor != amountMinor {
+			return nil, model.ErrPaymentMismatch
+		}
+		return existing, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Payment succeed, transaction_uuid: %s", payment.TransactionUuid)
+	return payment, nil
+}
@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+)
+
+func (s *service) Refund(ctx context.Context, transactionUuid, refundUuid string, amountMinor int64, reason string) (*model.Payment, *model.Refund, error) {
+	refund := model.Refund{
+		TransactionUuid: uuid.NewString(),
+		RefundUuid:      refundUuid,
+		AmountMinor:     amountMinor,
+		Reason:          reason,
+		CreatedAt:       time.Now(),
+	}
+
+	payment, err := s.paymentRepository.AddRefund(ctx, transactionUuid, refund)
+	if errors.Is(err, model.ErrRefundAlreadyExists) {
+		// The refund is made once, retries get the original transaction.
+		payment, err := s.paymentRepository.Get(ctx, transactionUuid)
+		if err != nil {
+			return nil, nil, err
+		}
+		existing, _ := payment.Refund(refundUuid)
+		if existing.AmountMinor != amountMinor {
+			return nil, nil, model.ErrRefundMismatch
+		}
+		return payment, existing, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("Refund succeed, transaction_uuid: %s, refund_transaction_uuid: %s", transactionUuid, refund.TransactionUuid)
+	return payment, &refund, nil
+}
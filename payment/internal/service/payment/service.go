@@ -0,0 +1,18 @@
+package payment
+
+import (
+	"github.com/qyrlabs/test-backend/payment/internal/repository"
+	def "github.com/qyrlabs/test-backend/payment/internal/service"
+)
+
+var _ def.PaymentService = &service{}
+
+type service struct {
+	paymentRepository repository.PaymentRepository
+}
+
+func NewService(paymentRepository repository.PaymentRepository) *service {
+	return &service{
+		paymentRepository: paymentRepository,
+	}
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/qyrlabs/test-backend/payment/internal/model"
+)
+
+type PaymentService interface {
+	// Pay pays the order, or the tender of the order if tenderUuid is not
+	// empty. Paying an already paid order or tender with the same user,
+	// payment method and amount returns the existing payment.
+	Pay(ctx context.Context, orderUuid, tenderUuid, userUuid string, paymentMethod model.PaymentMethod, amountMinor int64) (*model.Payment, error)
+	// Refund refunds amountMinor of the payment and returns the updated payment
+	// and the refund. Refunding again with the same non-empty refundUuid and
+	// amount returns the existing refund.
+	Refund(ctx context.Context, transactionUuid, refundUuid string, amountMinor int64, reason string) (*model.Payment, *model.Refund, error)
+}
@@ -0,0 +1,198 @@
+// Package config loads service configuration. A configuration is a struct of
+// settings, nested structs being sections. Every setting is taken, in order of
+// precedence, from a command line flag, an environment variable, a YAML file
+// or the defaults of the service.
+//
+// Fields are tagged with their yaml name, the usage shown in -help and
+// reload:"true" if a changed value may be applied on SIGHUP without a restart:
+//
+//	type Config struct {
+//		Gateway struct {
+//			Addr string `yaml:"addr" usage:"address of HTTP/JSON gateway"`
+//		} `yaml:"gateway"`
+//		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" reload:"true"`
+//	}
+//
+// Here gateway.addr is set by -gateway-addr, by <PREFIX>_GATEWAY_ADDR or by
+// addr in the gateway section of the file. A configuration implementing
+// Validate() error is validated once loaded.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Loader loads configurations of type T, a struct.
+type Loader[T any] struct {
+	// Prefix of environment variables.
+	prefix   string
+	defaults func() *T
+	settings []setting
+	// Path of the YAML file, no file is read if empty.
+	path string
+	// Whether the configuration is to be printed instead of running the service.
+	print bool
+	// Values of the settings set on the command line, by flag name.
+	flags map[string]string
+}
+
+// NewLoader creates a loader of configurations starting from defaults. Environment
+// variables of the settings are named prefix, an underscore and the path of the
+// setting, for example ORDER_HTTP_ADDR for http.addr with prefix ORDER.
+func NewLoader[T any](prefix string, defaults func() *T) *Loader[T] {
+	return &Loader[T]{
+		prefix:   prefix,
+		defaults: defaults,
+		settings: settings(reflect.TypeFor[T]()),
+		flags:    make(map[string]string),
+	}
+}
+
+// RegisterFlags defines a flag for every setting on fs, along with -config,
+// the path of the YAML file, and -print-config. It is called before fs is parsed.
+func (l *Loader[T]) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&l.path, "config", os.Getenv(l.prefix+"_CONFIG"), "path to a YAML config file, also set by "+l.prefix+"_CONFIG")
+	fs.BoolVar(&l.print, "print-config", false, "write the effective config as YAML to stdout and exit")
+
+	defaults := reflect.ValueOf(l.defaults()).Elem()
+	for _, s := range l.settings {
+		usage := s.usage
+		if usage != "" {
+			usage += ", "
+		}
+		usage += "also set by " + s.env(l.prefix)
+		fs.Var(&flagValue{flags: l.flags, setting: s, text: format(s.value(defaults))}, s.flag(), usage)
+	}
+}
+
+// PrintOnly reports whether -print-config was given.
+func (l *Loader[T]) PrintOnly() bool {
+	return l.print
+}
+
+// Load builds the configuration from the defaults, the file, the environment
+// and the flags, and validates it.
+func (l *Loader[T]) Load() (*T, error) {
+	cfg := l.defaults()
+	v := reflect.ValueOf(cfg).Elem()
+
+	if l.path != "" {
+		if err := readFile(l.path, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range l.settings {
+		if text, ok := os.LookupEnv(s.env(l.prefix)); ok {
+			if err := s.set(v, text); err != nil {
+				return nil, fmt.Errorf("%s: %w", s.env(l.prefix), err)
+			}
+		}
+	}
+
+	for _, s := range l.settings {
+		if text, ok := l.flags[s.flag()]; ok {
+			if err := s.set(v, text); err != nil {
+				return nil, fmt.Errorf("-%s: %w", s.flag(), err)
+			}
+		}
+	}
+
+	if validator, ok := any(cfg).(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
+// Reload loads the configuration again. Changes of settings not tagged
+// reload:"true" are logged and left out, they are kept as in current.
+func (l *Loader[T]) Reload(current *T) (*T, error) {
+	next, err := l.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	currentValue := reflect.ValueOf(current).Elem()
+	nextValue := reflect.ValueOf(next).Elem()
+	for _, s := range l.settings {
+		was, is := s.value(currentValue), s.value(nextValue)
+		if was.Equal(is) {
+			continue
+		}
+		if !s.reload {
+			log.Printf("config: %s changed to %s, restart to apply", s.name(), format(is))
+			is.Set(was)
+			continue
+		}
+		log.Printf("config: %s changed from %s to %s", s.name(), format(was), format(is))
+	}
+	return next, nil
+}
+
+// readFile decodes the YAML file at path into cfg. Settings missing in the
+// file keep their values, unknown ones are an error.
+func readFile(path string, cfg any) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Format writes the configuration as YAML, the way a config file is read.
+func Format(cfg any) (string, error) {
+	var out strings.Builder
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg); err != nil {
+		return "", fmt.Errorf("failed to format config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to format config: %w", err)
+	}
+	return out.String(), nil
+}
+
+// flagValue is the flag of a setting. Values are collected and applied on
+// top of the file and the environment when the configuration is loaded.
+type flagValue struct {
+	flags   map[string]string
+	setting setting
+	text    string
+}
+
+func (f *flagValue) String() string {
+	return f.text
+}
+
+func (f *flagValue) Set(text string) error {
+	if _, err := parse(f.setting.typ, text); err != nil {
+		return err
+	}
+	f.text = text
+	f.flags[f.setting.flag()] = text
+	return nil
+}
+
+// IsBoolFlag lets boolean settings be set by the flag alone.
+func (f *flagValue) IsBoolFlag() bool {
+	return f.setting.typ.Kind() == reflect.Bool
+}
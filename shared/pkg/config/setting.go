@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// setting is a leaf field of a configuration struct.
+type setting struct {
+	// Path of yaml names from the root of the configuration.
+	path  []string
+	index []int
+	typ   reflect.Type
+	usage string
+	// Whether a changed value is applied on reload, otherwise it takes a restart.
+	reload bool
+}
+
+// settings lists the leaf fields of the configuration struct typ. Nested
+// structs are sections, their fields are prefixed by the name of the section.
+func settings(typ reflect.Type) []setting {
+	var result []setting
+	walk(typ, nil, nil, &result)
+	return result
+}
+
+func walk(typ reflect.Type, path []string, index []int, result *[]setting) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		fieldPath := append(append([]string(nil), path...), name)
+		fieldIndex := append(append([]int(nil), index...), i)
+		if field.Type.Kind() == reflect.Struct {
+			walk(field.Type, fieldPath, fieldIndex, result)
+			continue
+		}
+		*result = append(*result, setting{
+			path:   fieldPath,
+			index:  fieldIndex,
+			typ:    field.Type,
+			usage:  field.Tag.Get("usage"),
+			reload: field.Tag.Get("reload") == "true",
+		})
+	}
+}
+
+// name is the dotted path of the setting as it is reported in logs.
+func (s setting) name() string {
+	return strings.Join(s.path, ".")
+}
+
+// flag is the name of the command line flag of the setting, for example
+// gateway-addr for gateway.addr.
+func (s setting) flag() string {
+	return strings.ReplaceAll(strings.Join(s.path, "-"), "_", "-")
+}
+
+// env is the name of the environment variable of the setting, for example
+// INVENTORY_GATEWAY_ADDR for gateway.addr of inventory.
+func (s setting) env(prefix string) string {
+	return prefix + "_" + strings.ToUpper(strings.Join(s.path, "_"))
+}
+
+// value returns the field of the setting in the configuration struct cfg.
+func (s setting) value(cfg reflect.Value) reflect.Value {
+	return cfg.FieldByIndex(s.index)
+}
+
+// set parses text into the field of the setting in cfg.
+func (s setting) set(cfg reflect.Value, text string) error {
+	v, err := parse(s.typ, text)
+	if err != nil {
+		return fmt.Errorf("invalid value %q of %s: %w", text, s.name(), err)
+	}
+	s.value(cfg).Set(v)
+	return nil
+}
+
+// parse parses text as a value of typ. Durations are written as accepted by
+// time.ParseDuration, for example 1m30s.
+func parse(typ reflect.Type, text string) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+	switch {
+	case typ == durationType:
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(int64(d))
+	case typ.Kind() == reflect.String:
+		v.SetString(text)
+	case typ.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case v.CanInt():
+		n, err := strconv.ParseInt(text, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(n)
+	case v.CanUint():
+		n, err := strconv.ParseUint(text, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(n)
+	case v.CanFloat():
+		f, err := strconv.ParseFloat(text, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	default:
+		return v, fmt.Errorf("unsupported type %s", typ)
+	}
+	return v, nil
+}
+
+// format writes a value of a setting the way parse reads it.
+func format(v reflect.Value) string {
+	return fmt.Sprint(v.Interface())
+}
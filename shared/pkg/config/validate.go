@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// ValidateAddr checks that the setting name is a host:port address.
+func ValidateAddr(name, addr string) error {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// ValidatePositive checks that the setting name is above zero.
+func ValidatePositive[N int | int64 | time.Duration](name string, value N) error {
+	if value <= 0 {
+		return fmt.Errorf("%s must be positive, got %v", name, value)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Watch reloads the configuration on SIGHUP until ctx is done and passes it
+// to apply. A configuration failing to load is logged and the current one
+// stays in effect.
+func (l *Loader[T]) Watch(ctx context.Context, current *T, apply func(cfg *T)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			next, err := l.Reload(current)
+			if err != nil {
+				log.Printf("config: failed to reload, keeping the current config: %v", err)
+				continue
+			}
+			apply(next)
+			current = next
+			log.Println("config: reloaded")
+		}
+	}
+}
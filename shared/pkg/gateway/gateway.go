@@ -0,0 +1,126 @@
+// Package gateway provides HTTP/JSON transcoding of gRPC services.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const readHeaderTimeout = 5 * time.Second
+
+// RegisterFunc registers handlers of a gRPC service served on endpoint.
+// Generated Register<Service>HandlerFromEndpoint functions satisfy it.
+type RegisterFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+
+// NewServer creates an HTTP server listening on addr that transcodes
+// requests to the gRPC services served on grpcEndpoint.
+func NewServer(ctx context.Context, addr, grpcEndpoint string, register ...RegisterFunc) (*http.Server, error) {
+	mux := NewServeMux()
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	for _, r := range register {
+		if err := r(ctx, mux, grpcEndpoint, opts); err != nil {
+			return nil, fmt.Errorf("failed to register gateway handler: %w", err)
+		}
+	}
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}, nil
+}
+
+// NewServeMux creates a gateway mux which uses proto field names in JSON
+// and maps gRPC status codes to HTTP statuses with HTTPStatusFromCode.
+func NewServeMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithRoutingErrorHandler(routingErrorHandler),
+	)
+}
+
+// HTTPStatusFromCode maps gRPC status code to HTTP status.
+//
+// Validation errors are reported as 422 and failed preconditions as 409
+// to match the order service REST API.
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusUnprocessableEntity
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorHandler writes google.rpc.Status body with HTTP status from HTTPStatusFromCode.
+// Errors carrying their own HTTP status, such as routing errors, keep it.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var statusErr *runtime.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, &runtime.HTTPStatusError{
+		HTTPStatus: HTTPStatusFromCode(status.Code(err)),
+		Err:        err,
+	})
+}
+
+// routingErrorHandler reports requests matching no handler with their own HTTP
+// status, so that a path served with another method is 405 rather than the 501
+// of codes.Unimplemented.
+func routingErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	code := codes.Internal
+	switch httpStatus {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusMethodNotAllowed:
+		code = codes.Unimplemented
+	case http.StatusNotFound:
+		code = codes.NotFound
+	}
+	errorHandler(ctx, mux, marshaler, w, r, &runtime.HTTPStatusError{
+		HTTPStatus: httpStatus,
+		Err:        status.Error(code, http.StatusText(httpStatus)),
+	})
+}
@@ -9,10 +9,54 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type CreatePromoCodeRes interface {
+	createPromoCodeRes()
+}
+
+type CreateWebhookRes interface {
+	createWebhookRes()
+}
+
+type DeletePromoCodeRes interface {
+	deletePromoCodeRes()
+}
+
+type DeleteWebhookRes interface {
+	deleteWebhookRes()
+}
+
 type GetOrderByUuidRes interface {
 	getOrderByUuidRes()
 }
 
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
+type GetPromoCodeRes interface {
+	getPromoCodeRes()
+}
+
+type GetSalesReportRes interface {
+	getSalesReportRes()
+}
+
+type GetWebhookRes interface {
+	getWebhookRes()
+}
+
+type ListOrdersRes interface {
+	listOrdersRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type RefundOrderRes interface {
+	refundOrderRes()
+}
+
+type UpdateOrderRes interface {
+	updateOrderRes()
+}
@@ -6,8 +6,22 @@ package v1
 type OperationName = string
 
 const (
-	CancelOrderOperation    OperationName = "CancelOrder"
-	CreateOrderOperation    OperationName = "CreateOrder"
-	GetOrderByUuidOperation OperationName = "GetOrderByUuid"
-	PayOrderOperation       OperationName = "PayOrder"
+	CancelOrderOperation            OperationName = "CancelOrder"
+	CreateOrderOperation            OperationName = "CreateOrder"
+	CreatePromoCodeOperation        OperationName = "CreatePromoCode"
+	CreateWebhookOperation          OperationName = "CreateWebhook"
+	DeletePromoCodeOperation        OperationName = "DeletePromoCode"
+	DeleteWebhookOperation          OperationName = "DeleteWebhook"
+	GetOrderByUuidOperation         OperationName = "GetOrderByUuid"
+	GetOrderHistoryOperation        OperationName = "GetOrderHistory"
+	GetPromoCodeOperation           OperationName = "GetPromoCode"
+	GetSalesReportOperation         OperationName = "GetSalesReport"
+	GetWebhookOperation             OperationName = "GetWebhook"
+	ListOrdersOperation             OperationName = "ListOrders"
+	ListPromoCodesOperation         OperationName = "ListPromoCodes"
+	ListWebhookDeadLettersOperation OperationName = "ListWebhookDeadLetters"
+	ListWebhooksOperation           OperationName = "ListWebhooks"
+	PayOrderOperation               OperationName = "PayOrder"
+	RefundOrderOperation            OperationName = "RefundOrder"
+	UpdateOrderOperation            OperationName = "UpdateOrder"
 )
@@ -4,6 +4,8 @@ package v1
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -13,6 +15,8 @@ func (s *GenericErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type AmountOffMinor int64
+
 // Ref: #
 type BadGatewayError struct {
 	// HTTP-код ошибки.
@@ -43,7 +47,12 @@ func (s *BadGatewayError) SetMessage(val string) {
 
 func (*BadGatewayError) createOrderRes()    {}
 func (*BadGatewayError) getOrderByUuidRes() {}
+func (*BadGatewayError) getSalesReportRes() {}
 func (*BadGatewayError) payOrderRes()       {}
+func (*BadGatewayError) refundOrderRes()    {}
+func (*BadGatewayError) updateOrderRes()    {}
+
+type Code string
 
 // Ref: #
 type ConflictError struct {
@@ -73,8 +82,22 @@ func (s *ConflictError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ConflictError) cancelOrderRes() {}
-func (*ConflictError) payOrderRes()    {}
+func (*ConflictError) cancelOrderRes()     {}
+func (*ConflictError) createOrderRes()     {}
+func (*ConflictError) createPromoCodeRes() {}
+func (*ConflictError) createWebhookRes()   {}
+func (*ConflictError) refundOrderRes()     {}
+func (*ConflictError) updateOrderRes()     {}
+
+// DeletePromoCodeNoContent is response for DeletePromoCode operation.
+type DeletePromoCodeNoContent struct{}
+
+func (*DeletePromoCodeNoContent) deletePromoCodeRes() {}
+
+// DeleteWebhookNoContent is response for DeleteWebhook operation.
+type DeleteWebhookNoContent struct{}
+
+func (*DeleteWebhookNoContent) deleteWebhookRes() {}
 
 // Ref: #
 type GenericError struct {
@@ -130,6 +153,154 @@ func (s *GenericErrorStatusCode) SetResponse(val GenericError) {
 	s.Response = val
 }
 
+// Изменение детали заказа, обнаруженное при оплате.
+// Ref: #
+type ItemChange struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали на момент оформления заказа.
+	PartName string         `json:"part_name"`
+	Change   ItemChangeKind `json:"change"`
+	// Заказанное количество.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу на момент оформления заказа в
+	// копейках.
+	OrderedPriceMinor int64 `json:"ordered_price_minor"`
+	// Текущая цена за единицу в копейках, отсутствует для
+	// снятой с продажи детали.
+	CurrentPriceMinor OptInt64 `json:"current_price_minor"`
+	// Текущий остаток на складе, отсутствует для снятой с
+	// продажи детали.
+	StockQuantity OptInt64 `json:"stock_quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *ItemChange) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetPartName returns the value of PartName.
+func (s *ItemChange) GetPartName() string {
+	return s.PartName
+}
+
+// GetChange returns the value of Change.
+func (s *ItemChange) GetChange() ItemChangeKind {
+	return s.Change
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ItemChange) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetOrderedPriceMinor returns the value of OrderedPriceMinor.
+func (s *ItemChange) GetOrderedPriceMinor() int64 {
+	return s.OrderedPriceMinor
+}
+
+// GetCurrentPriceMinor returns the value of CurrentPriceMinor.
+func (s *ItemChange) GetCurrentPriceMinor() OptInt64 {
+	return s.CurrentPriceMinor
+}
+
+// GetStockQuantity returns the value of StockQuantity.
+func (s *ItemChange) GetStockQuantity() OptInt64 {
+	return s.StockQuantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *ItemChange) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetPartName sets the value of PartName.
+func (s *ItemChange) SetPartName(val string) {
+	s.PartName = val
+}
+
+// SetChange sets the value of Change.
+func (s *ItemChange) SetChange(val ItemChangeKind) {
+	s.Change = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *ItemChange) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetOrderedPriceMinor sets the value of OrderedPriceMinor.
+func (s *ItemChange) SetOrderedPriceMinor(val int64) {
+	s.OrderedPriceMinor = val
+}
+
+// SetCurrentPriceMinor sets the value of CurrentPriceMinor.
+func (s *ItemChange) SetCurrentPriceMinor(val OptInt64) {
+	s.CurrentPriceMinor = val
+}
+
+// SetStockQuantity sets the value of StockQuantity.
+func (s *ItemChange) SetStockQuantity(val OptInt64) {
+	s.StockQuantity = val
+}
+
+// Изменение детали заказа на складе с момента
+// оформления заказа.
+// Ref: #
+type ItemChangeKind string
+
+const (
+	ItemChangeKindITEMCHANGEDISCONTINUED ItemChangeKind = "ITEM_CHANGE_DISCONTINUED"
+	ItemChangeKindITEMCHANGEOUTOFSTOCK   ItemChangeKind = "ITEM_CHANGE_OUT_OF_STOCK"
+	ItemChangeKindITEMCHANGEPRICECHANGED ItemChangeKind = "ITEM_CHANGE_PRICE_CHANGED"
+)
+
+// AllValues returns all ItemChangeKind values.
+func (ItemChangeKind) AllValues() []ItemChangeKind {
+	return []ItemChangeKind{
+		ItemChangeKindITEMCHANGEDISCONTINUED,
+		ItemChangeKindITEMCHANGEOUTOFSTOCK,
+		ItemChangeKindITEMCHANGEPRICECHANGED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ItemChangeKind) MarshalText() ([]byte, error) {
+	switch s {
+	case ItemChangeKindITEMCHANGEDISCONTINUED:
+		return []byte(s), nil
+	case ItemChangeKindITEMCHANGEOUTOFSTOCK:
+		return []byte(s), nil
+	case ItemChangeKindITEMCHANGEPRICECHANGED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ItemChangeKind) UnmarshalText(data []byte) error {
+	switch ItemChangeKind(data) {
+	case ItemChangeKindITEMCHANGEDISCONTINUED:
+		*s = ItemChangeKindITEMCHANGEDISCONTINUED
+		return nil
+	case ItemChangeKindITEMCHANGEOUTOFSTOCK:
+		*s = ItemChangeKindITEMCHANGEOUTOFSTOCK
+		return nil
+	case ItemChangeKindITEMCHANGEPRICECHANGED:
+		*s = ItemChangeKindITEMCHANGEPRICECHANGED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type MaxUses int64
+
+type MaxUsesPerUser int64
+
+type MinOrderTotalMinor int64
+
 // Ref: #
 type NotFoundError struct {
 	// HTTP-код ошибки.
@@ -158,42 +329,49 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes()    {}
-func (*NotFoundError) getOrderByUuidRes() {}
-func (*NotFoundError) payOrderRes()       {}
-
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+func (*NotFoundError) cancelOrderRes()     {}
+func (*NotFoundError) deletePromoCodeRes() {}
+func (*NotFoundError) deleteWebhookRes()   {}
+func (*NotFoundError) getOrderByUuidRes()  {}
+func (*NotFoundError) getOrderHistoryRes() {}
+func (*NotFoundError) getPromoCodeRes()    {}
+func (*NotFoundError) getWebhookRes()      {}
+func (*NotFoundError) payOrderRes()        {}
+func (*NotFoundError) refundOrderRes()     {}
+func (*NotFoundError) updateOrderRes()     {}
+
+// NewOptAmountOffMinor returns new OptAmountOffMinor with value set to v.
+func NewOptAmountOffMinor(v AmountOffMinor) OptAmountOffMinor {
+	return OptAmountOffMinor{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptAmountOffMinor is optional AmountOffMinor.
+type OptAmountOffMinor struct {
+	Value AmountOffMinor
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptAmountOffMinor was set.
+func (o OptAmountOffMinor) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptAmountOffMinor) Reset() {
+	var v AmountOffMinor
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptAmountOffMinor) SetTo(v AmountOffMinor) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptAmountOffMinor) Get() (v AmountOffMinor, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -201,45 +379,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptAmountOffMinor) Or(d AmountOffMinor) AmountOffMinor {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
-func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
-	return OptPaymentMethod{
+// NewOptCode returns new OptCode with value set to v.
+func NewOptCode(v Code) OptCode {
+	return OptCode{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptPaymentMethod is optional PaymentMethod.
-type OptPaymentMethod struct {
-	Value PaymentMethod
+// OptCode is optional Code.
+type OptCode struct {
+	Value Code
 	Set   bool
 }
 
-// IsSet returns true if OptPaymentMethod was set.
-func (o OptPaymentMethod) IsSet() bool { return o.Set }
+// IsSet returns true if OptCode was set.
+func (o OptCode) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPaymentMethod) Reset() {
-	var v PaymentMethod
+func (o *OptCode) Reset() {
+	var v Code
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+func (o *OptCode) SetTo(v Code) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+func (o OptCode) Get() (v Code, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -247,45 +425,45 @@ func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+func (o OptCode) Or(d Code) Code {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptDate) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptDate) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptDate) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -293,45 +471,45 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptDate) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
+func (o *OptDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
+func (o *OptDateTime) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+func (o OptDateTime) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -339,216 +517,2907 @@ func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+func (o OptDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #
-type Order struct {
-	// UUID заказа.
-	OrderUUID uuid.UUID `json:"order_uuid"`
-	// UUID пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
-	PartUuids []uuid.UUID `json:"part_uuids"`
-	// Сумма заказа в копейках.
-	TotalPriceMinor int64 `json:"total_price_minor"`
-	// UUID транзакции.
-	TransactionUUID OptUUID          `json:"transaction_uuid"`
-	PaymentMethod   OptPaymentMethod `json:"payment_method"`
-	Status          OrderStatus      `json:"status"`
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *Order) GetOrderUUID() uuid.UUID {
-	return s.OrderUUID
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *Order) GetUserUUID() uuid.UUID {
-	return s.UserUUID
-}
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *Order) GetPartUuids() []uuid.UUID {
-	return s.PartUuids
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
 }
 
-// GetTotalPriceMinor returns the value of TotalPriceMinor.
-func (s *Order) GetTotalPriceMinor() int64 {
-	return s.TotalPriceMinor
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *Order) GetTransactionUUID() OptUUID {
-	return s.TransactionUUID
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *Order) GetPaymentMethod() OptPaymentMethod {
-	return s.PaymentMethod
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetStatus returns the value of Status.
-func (s *Order) GetStatus() OrderStatus {
-	return s.Status
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *Order) SetOrderUUID(val uuid.UUID) {
-	s.OrderUUID = val
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *Order) SetUserUUID(val uuid.UUID) {
-	s.UserUUID = val
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *Order) SetPartUuids(val []uuid.UUID) {
-	s.PartUuids = val
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetTotalPriceMinor sets the value of TotalPriceMinor.
-func (s *Order) SetTotalPriceMinor(val int64) {
-	s.TotalPriceMinor = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *Order) SetTransactionUUID(val OptUUID) {
-	s.TransactionUUID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *Order) SetPaymentMethod(val OptPaymentMethod) {
-	s.PaymentMethod = val
+// NewOptMaxUses returns new OptMaxUses with value set to v.
+func NewOptMaxUses(v MaxUses) OptMaxUses {
+	return OptMaxUses{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetStatus sets the value of Status.
-func (s *Order) SetStatus(val OrderStatus) {
-	s.Status = val
+// OptMaxUses is optional MaxUses.
+type OptMaxUses struct {
+	Value MaxUses
+	Set   bool
 }
 
-func (*Order) cancelOrderRes()    {}
-func (*Order) getOrderByUuidRes() {}
+// IsSet returns true if OptMaxUses was set.
+func (o OptMaxUses) IsSet() bool { return o.Set }
 
-// Ref: #
-type OrderCreateRequest struct {
-	UserUUID  UserUUID  `json:"user_uuid"`
-	PartUuids PartUuids `json:"part_uuids"`
+// Reset unsets value.
+func (o *OptMaxUses) Reset() {
+	var v MaxUses
+	o.Value = v
+	o.Set = false
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *OrderCreateRequest) GetUserUUID() UserUUID {
-	return s.UserUUID
+// SetTo sets value to v.
+func (o *OptMaxUses) SetTo(v MaxUses) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetPartUuids returns the value of PartUuids.
-func (s *OrderCreateRequest) GetPartUuids() PartUuids {
-	return s.PartUuids
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaxUses) Get() (v MaxUses, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *OrderCreateRequest) SetUserUUID(val UserUUID) {
-	s.UserUUID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptMaxUses) Or(d MaxUses) MaxUses {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetPartUuids sets the value of PartUuids.
-func (s *OrderCreateRequest) SetPartUuids(val PartUuids) {
-	s.PartUuids = val
+// NewOptMaxUsesPerUser returns new OptMaxUsesPerUser with value set to v.
+func NewOptMaxUsesPerUser(v MaxUsesPerUser) OptMaxUsesPerUser {
+	return OptMaxUsesPerUser{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// Ref: #
-type OrderCreateResponse struct {
-	OrderUUID       OrderUUID       `json:"order_uuid"`
-	TotalPriceMinor TotalPriceMinor `json:"total_price_minor"`
+// OptMaxUsesPerUser is optional MaxUsesPerUser.
+type OptMaxUsesPerUser struct {
+	Value MaxUsesPerUser
+	Set   bool
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *OrderCreateResponse) GetOrderUUID() OrderUUID {
+// IsSet returns true if OptMaxUsesPerUser was set.
+func (o OptMaxUsesPerUser) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMaxUsesPerUser) Reset() {
+	var v MaxUsesPerUser
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMaxUsesPerUser) SetTo(v MaxUsesPerUser) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMaxUsesPerUser) Get() (v MaxUsesPerUser, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMaxUsesPerUser) Or(d MaxUsesPerUser) MaxUsesPerUser {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMinOrderTotalMinor returns new OptMinOrderTotalMinor with value set to v.
+func NewOptMinOrderTotalMinor(v MinOrderTotalMinor) OptMinOrderTotalMinor {
+	return OptMinOrderTotalMinor{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMinOrderTotalMinor is optional MinOrderTotalMinor.
+type OptMinOrderTotalMinor struct {
+	Value MinOrderTotalMinor
+	Set   bool
+}
+
+// IsSet returns true if OptMinOrderTotalMinor was set.
+func (o OptMinOrderTotalMinor) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMinOrderTotalMinor) Reset() {
+	var v MinOrderTotalMinor
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMinOrderTotalMinor) SetTo(v MinOrderTotalMinor) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMinOrderTotalMinor) Get() (v MinOrderTotalMinor, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMinOrderTotalMinor) Or(d MinOrderTotalMinor) MinOrderTotalMinor {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrder returns new OptOrder with value set to v.
+func NewOptOrder(v Order) OptOrder {
+	return OptOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrder is optional Order.
+type OptOrder struct {
+	Value Order
+	Set   bool
+}
+
+// IsSet returns true if OptOrder was set.
+func (o OptOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrder) Reset() {
+	var v Order
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrder) SetTo(v Order) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrder) Get() (v Order, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrder) Or(d Order) Order {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderSortBy returns new OptOrderSortBy with value set to v.
+func NewOptOrderSortBy(v OrderSortBy) OptOrderSortBy {
+	return OptOrderSortBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderSortBy is optional OrderSortBy.
+type OptOrderSortBy struct {
+	Value OrderSortBy
+	Set   bool
+}
+
+// IsSet returns true if OptOrderSortBy was set.
+func (o OptOrderSortBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderSortBy) Reset() {
+	var v OrderSortBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderSortBy) SetTo(v OrderSortBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderSortBy) Get() (v OrderSortBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderSortBy) Or(d OrderSortBy) OrderSortBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrderStatus returns new OptOrderStatus with value set to v.
+func NewOptOrderStatus(v OrderStatus) OptOrderStatus {
+	return OptOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrderStatus is optional OrderStatus.
+type OptOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+}
+
+// IsSet returns true if OptOrderStatus was set.
+func (o OptOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrderStatus) Get() (v OrderStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPartCategory returns new OptPartCategory with value set to v.
+func NewOptPartCategory(v PartCategory) OptPartCategory {
+	return OptPartCategory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPartCategory is optional PartCategory.
+type OptPartCategory struct {
+	Value PartCategory
+	Set   bool
+}
+
+// IsSet returns true if OptPartCategory was set.
+func (o OptPartCategory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPartCategory) Reset() {
+	var v PartCategory
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPartCategory) SetTo(v PartCategory) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPartCategory) Get() (v PartCategory, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPartCategory) Or(d PartCategory) PartCategory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPartSummary returns new OptPartSummary with value set to v.
+func NewOptPartSummary(v PartSummary) OptPartSummary {
+	return OptPartSummary{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPartSummary is optional PartSummary.
+type OptPartSummary struct {
+	Value PartSummary
+	Set   bool
+}
+
+// IsSet returns true if OptPartSummary was set.
+func (o OptPartSummary) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPartSummary) Reset() {
+	var v PartSummary
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPartSummary) SetTo(v PartSummary) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPartSummary) Get() (v PartSummary, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPartSummary) Or(d PartSummary) PartSummary {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
+func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
+	return OptPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPaymentMethod is optional PaymentMethod.
+type OptPaymentMethod struct {
+	Value PaymentMethod
+	Set   bool
+}
+
+// IsSet returns true if OptPaymentMethod was set.
+func (o OptPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPaymentMethod) Reset() {
+	var v PaymentMethod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPercentOff returns new OptPercentOff with value set to v.
+func NewOptPercentOff(v PercentOff) OptPercentOff {
+	return OptPercentOff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPercentOff is optional PercentOff.
+type OptPercentOff struct {
+	Value PercentOff
+	Set   bool
+}
+
+// IsSet returns true if OptPercentOff was set.
+func (o OptPercentOff) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPercentOff) Reset() {
+	var v PercentOff
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPercentOff) SetTo(v PercentOff) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPercentOff) Get() (v PercentOff, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPercentOff) Or(d PercentOff) PercentOff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSortOrder returns new OptSortOrder with value set to v.
+func NewOptSortOrder(v SortOrder) OptSortOrder {
+	return OptSortOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSortOrder is optional SortOrder.
+type OptSortOrder struct {
+	Value SortOrder
+	Set   bool
+}
+
+// IsSet returns true if OptSortOrder was set.
+func (o OptSortOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSortOrder) Reset() {
+	var v SortOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSortOrder) SetTo(v SortOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSortOrder) Get() (v SortOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSortOrder) Or(d SortOrder) SortOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptValidUntil returns new OptValidUntil with value set to v.
+func NewOptValidUntil(v ValidUntil) OptValidUntil {
+	return OptValidUntil{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptValidUntil is optional ValidUntil.
+type OptValidUntil struct {
+	Value ValidUntil
+	Set   bool
+}
+
+// IsSet returns true if OptValidUntil was set.
+func (o OptValidUntil) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptValidUntil) Reset() {
+	var v ValidUntil
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptValidUntil) SetTo(v ValidUntil) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptValidUntil) Get() (v ValidUntil, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptValidUntil) Or(d ValidUntil) ValidUntil {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #
+type Order struct {
+	// UUID заказа.
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Версия заказа, растёт при каждом изменении и
+	// передаётся в заголовке ETag.
+	Version int64 `json:"version"`
+	// UUID пользователя.
+	UserUUID uuid.UUID `json:"user_uuid"`
+	// Позиции заказа.
+	Items []OrderItem `json:"items"`
+	// Сумма заказа до скидки в копейках, равна сумме
+	// стоимостей позиций.
+	SubtotalMinor int64 `json:"subtotal_minor"`
+	// Применённый промокод.
+	PromoCode OptString `json:"promo_code"`
+	// Скидка по промокоду в копейках, равна сумме скидок
+	// позиций.
+	DiscountMinor int64 `json:"discount_minor"`
+	// Стоимость доставки в копейках без налога.
+	ShippingMinor int64 `json:"shipping_minor"`
+	// НДС в копейках, сумма налогов позиций и доставки.
+	TaxMinor int64 `json:"tax_minor"`
+	// НДС по ставкам.
+	Taxes    []TaxLine `json:"taxes"`
+	Shipment Shipment  `json:"shipment"`
+	// Итоговая сумма заказа в копейках: subtotal_minor - discount_minor +
+	// shipping_minor + tax_minor.
+	// Цены указаны без НДС, налог каждой позиции и доставки
+	// округляется до копейки, половина вверх.
+	TotalPriceMinor int64 `json:"total_price_minor"`
+	// UUID транзакции первой части оплаты.
+	TransactionUUID OptUUID `json:"transaction_uuid"`
+	// Способ оплаты первой части.
+	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// Оплаченная сумма в копейках, при оплате частями
+	// растёт до total_price_minor.
+	PaidMinor int64 `json:"paid_minor"`
+	// Оплаченные части заказа в порядке списания.
+	Payments []OrderPayment `json:"payments"`
+	Status   OrderStatus    `json:"status"`
+	// Время создания заказа.
+	CreatedAt time.Time `json:"created_at"`
+	// Срок оплаты, после которого неоплаченный заказ
+	// отменяется.
+	PaymentDeadline time.Time `json:"payment_deadline"`
+	// Время оплаты заказа.
+	PaidAt OptDateTime `json:"paid_at"`
+	// Время отмены заказа.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	// Сумма возвратов в копейках.
+	RefundedTotalMinor int64 `json:"refunded_total_minor"`
+	// Возвраты по заказу в хронологическом порядке.
+	Refunds []OrderRefund `json:"refunds"`
+	// Раскрытия, выполненные не полностью из-за
+	// недоступности склада.
+	ExpandPartial []OrderExpand `json:"expand_partial"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Order) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetVersion returns the value of Version.
+func (s *Order) GetVersion() int64 {
+	return s.Version
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Order) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *Order) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetSubtotalMinor returns the value of SubtotalMinor.
+func (s *Order) GetSubtotalMinor() int64 {
+	return s.SubtotalMinor
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *Order) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetDiscountMinor returns the value of DiscountMinor.
+func (s *Order) GetDiscountMinor() int64 {
+	return s.DiscountMinor
+}
+
+// GetShippingMinor returns the value of ShippingMinor.
+func (s *Order) GetShippingMinor() int64 {
+	return s.ShippingMinor
+}
+
+// GetTaxMinor returns the value of TaxMinor.
+func (s *Order) GetTaxMinor() int64 {
+	return s.TaxMinor
+}
+
+// GetTaxes returns the value of Taxes.
+func (s *Order) GetTaxes() []TaxLine {
+	return s.Taxes
+}
+
+// GetShipment returns the value of Shipment.
+func (s *Order) GetShipment() Shipment {
+	return s.Shipment
+}
+
+// GetTotalPriceMinor returns the value of TotalPriceMinor.
+func (s *Order) GetTotalPriceMinor() int64 {
+	return s.TotalPriceMinor
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *Order) GetTransactionUUID() OptUUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *Order) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetPaidMinor returns the value of PaidMinor.
+func (s *Order) GetPaidMinor() int64 {
+	return s.PaidMinor
+}
+
+// GetPayments returns the value of Payments.
+func (s *Order) GetPayments() []OrderPayment {
+	return s.Payments
+}
+
+// GetStatus returns the value of Status.
+func (s *Order) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Order) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetPaymentDeadline returns the value of PaymentDeadline.
+func (s *Order) GetPaymentDeadline() time.Time {
+	return s.PaymentDeadline
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *Order) GetPaidAt() OptDateTime {
+	return s.PaidAt
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *Order) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
+}
+
+// GetRefundedTotalMinor returns the value of RefundedTotalMinor.
+func (s *Order) GetRefundedTotalMinor() int64 {
+	return s.RefundedTotalMinor
+}
+
+// GetRefunds returns the value of Refunds.
+func (s *Order) GetRefunds() []OrderRefund {
+	return s.Refunds
+}
+
+// GetExpandPartial returns the value of ExpandPartial.
+func (s *Order) GetExpandPartial() []OrderExpand {
+	return s.ExpandPartial
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Order) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Order) SetVersion(val int64) {
+	s.Version = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Order) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetItems sets the value of Items.
+func (s *Order) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
+// SetSubtotalMinor sets the value of SubtotalMinor.
+func (s *Order) SetSubtotalMinor(val int64) {
+	s.SubtotalMinor = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *Order) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetDiscountMinor sets the value of DiscountMinor.
+func (s *Order) SetDiscountMinor(val int64) {
+	s.DiscountMinor = val
+}
+
+// SetShippingMinor sets the value of ShippingMinor.
+func (s *Order) SetShippingMinor(val int64) {
+	s.ShippingMinor = val
+}
+
+// SetTaxMinor sets the value of TaxMinor.
+func (s *Order) SetTaxMinor(val int64) {
+	s.TaxMinor = val
+}
+
+// SetTaxes sets the value of Taxes.
+func (s *Order) SetTaxes(val []TaxLine) {
+	s.Taxes = val
+}
+
+// SetShipment sets the value of Shipment.
+func (s *Order) SetShipment(val Shipment) {
+	s.Shipment = val
+}
+
+// SetTotalPriceMinor sets the value of TotalPriceMinor.
+func (s *Order) SetTotalPriceMinor(val int64) {
+	s.TotalPriceMinor = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *Order) SetTransactionUUID(val OptUUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *Order) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetPaidMinor sets the value of PaidMinor.
+func (s *Order) SetPaidMinor(val int64) {
+	s.PaidMinor = val
+}
+
+// SetPayments sets the value of Payments.
+func (s *Order) SetPayments(val []OrderPayment) {
+	s.Payments = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Order) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Order) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetPaymentDeadline sets the value of PaymentDeadline.
+func (s *Order) SetPaymentDeadline(val time.Time) {
+	s.PaymentDeadline = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *Order) SetPaidAt(val OptDateTime) {
+	s.PaidAt = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *Order) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetRefundedTotalMinor sets the value of RefundedTotalMinor.
+func (s *Order) SetRefundedTotalMinor(val int64) {
+	s.RefundedTotalMinor = val
+}
+
+// SetRefunds sets the value of Refunds.
+func (s *Order) SetRefunds(val []OrderRefund) {
+	s.Refunds = val
+}
+
+// SetExpandPartial sets the value of ExpandPartial.
+func (s *Order) SetExpandPartial(val []OrderExpand) {
+	s.ExpandPartial = val
+}
+
+// Ref: #
+type OrderCreateRequest struct {
+	UserUUID UserUUID `json:"user_uuid"`
+	// Позиции заказа.
+	Items     []OrderItemRequest `json:"items"`
+	PromoCode OptCode            `json:"promo_code"`
+	// Страна доставки, код ISO 3166-1 alpha-2, по умолчанию задаётся
+	// настройками сервиса.
+	ShippingCountry OptString `json:"shipping_country"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *OrderCreateRequest) GetUserUUID() UserUUID {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *OrderCreateRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *OrderCreateRequest) GetPromoCode() OptCode {
+	return s.PromoCode
+}
+
+// GetShippingCountry returns the value of ShippingCountry.
+func (s *OrderCreateRequest) GetShippingCountry() OptString {
+	return s.ShippingCountry
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *OrderCreateRequest) SetUserUUID(val UserUUID) {
+	s.UserUUID = val
+}
+
+// SetItems sets the value of Items.
+func (s *OrderCreateRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *OrderCreateRequest) SetPromoCode(val OptCode) {
+	s.PromoCode = val
+}
+
+// SetShippingCountry sets the value of ShippingCountry.
+func (s *OrderCreateRequest) SetShippingCountry(val OptString) {
+	s.ShippingCountry = val
+}
+
+// Ref: #
+type OrderCreateResponse struct {
+	OrderUUID       OrderUUID       `json:"order_uuid"`
+	TotalPriceMinor TotalPriceMinor `json:"total_price_minor"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderCreateResponse) GetOrderUUID() OrderUUID {
 	return s.OrderUUID
 }
 
-// GetTotalPriceMinor returns the value of TotalPriceMinor.
-func (s *OrderCreateResponse) GetTotalPriceMinor() TotalPriceMinor {
-	return s.TotalPriceMinor
+// GetTotalPriceMinor returns the value of TotalPriceMinor.
+func (s *OrderCreateResponse) GetTotalPriceMinor() TotalPriceMinor {
+	return s.TotalPriceMinor
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *OrderCreateResponse) SetOrderUUID(val OrderUUID) {
+	s.OrderUUID = val
+}
+
+// SetTotalPriceMinor sets the value of TotalPriceMinor.
+func (s *OrderCreateResponse) SetTotalPriceMinor(val TotalPriceMinor) {
+	s.TotalPriceMinor = val
+}
+
+func (*OrderCreateResponse) createOrderRes() {}
+
+// Раскрываемые связанные данные заказа.
+// Ref: #
+type OrderExpand string
+
+const (
+	OrderExpandParts OrderExpand = "parts"
+)
+
+// AllValues returns all OrderExpand values.
+func (OrderExpand) AllValues() []OrderExpand {
+	return []OrderExpand{
+		OrderExpandParts,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderExpand) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderExpandParts:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderExpand) UnmarshalText(data []byte) error {
+	switch OrderExpand(data) {
+	case OrderExpandParts:
+		*s = OrderExpandParts
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// OrderHeaders wraps Order with response headers.
+type OrderHeaders struct {
+	Etag     OptString
+	Response Order
+}
+
+// GetEtag returns the value of Etag.
+func (s *OrderHeaders) GetEtag() OptString {
+	return s.Etag
+}
+
+// GetResponse returns the value of Response.
+func (s *OrderHeaders) GetResponse() Order {
+	return s.Response
+}
+
+// SetEtag sets the value of Etag.
+func (s *OrderHeaders) SetEtag(val OptString) {
+	s.Etag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *OrderHeaders) SetResponse(val Order) {
+	s.Response = val
+}
+
+func (*OrderHeaders) cancelOrderRes()    {}
+func (*OrderHeaders) getOrderByUuidRes() {}
+func (*OrderHeaders) refundOrderRes()    {}
+func (*OrderHeaders) updateOrderRes()    {}
+
+// Ref: #
+type OrderHistoryResponse struct {
+	OrderUUID OrderUUID `json:"order_uuid"`
+	// Переходы статуса заказа в хронологическом порядке.
+	Transitions []StatusTransition `json:"transitions"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderHistoryResponse) GetOrderUUID() OrderUUID {
+	return s.OrderUUID
+}
+
+// GetTransitions returns the value of Transitions.
+func (s *OrderHistoryResponse) GetTransitions() []StatusTransition {
+	return s.Transitions
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *OrderHistoryResponse) SetOrderUUID(val OrderUUID) {
+	s.OrderUUID = val
+}
+
+// SetTransitions sets the value of Transitions.
+func (s *OrderHistoryResponse) SetTransitions(val []StatusTransition) {
+	s.Transitions = val
+}
+
+func (*OrderHistoryResponse) getOrderHistoryRes() {}
+
+// Ref: #
+type OrderItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Название детали на момент оформления заказа.
+	PartName string `json:"part_name"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу в копейках на момент оформления
+	// заказа.
+	UnitPriceMinor int64 `json:"unit_price_minor"`
+	// Стоимость позиции в копейках.
+	LineTotalMinor int64 `json:"line_total_minor"`
+	// Скидка на позицию в копейках.
+	DiscountMinor int64 `json:"discount_minor"`
+	// НДС позиции в копейках, начисленный на стоимость за
+	// вычетом скидки.
+	TaxMinor int64 `json:"tax_minor"`
+	// Количество возвращённых деталей.
+	RefundedQuantity int64 `json:"refunded_quantity"`
+	// Деталь со склада при expand=parts, отсутствует для детали,
+	// удалённой со склада, и при недоступности склада.
+	Part OptPartSummary `json:"part"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetPartName returns the value of PartName.
+func (s *OrderItem) GetPartName() string {
+	return s.PartName
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPriceMinor returns the value of UnitPriceMinor.
+func (s *OrderItem) GetUnitPriceMinor() int64 {
+	return s.UnitPriceMinor
+}
+
+// GetLineTotalMinor returns the value of LineTotalMinor.
+func (s *OrderItem) GetLineTotalMinor() int64 {
+	return s.LineTotalMinor
+}
+
+// GetDiscountMinor returns the value of DiscountMinor.
+func (s *OrderItem) GetDiscountMinor() int64 {
+	return s.DiscountMinor
+}
+
+// GetTaxMinor returns the value of TaxMinor.
+func (s *OrderItem) GetTaxMinor() int64 {
+	return s.TaxMinor
+}
+
+// GetRefundedQuantity returns the value of RefundedQuantity.
+func (s *OrderItem) GetRefundedQuantity() int64 {
+	return s.RefundedQuantity
+}
+
+// GetPart returns the value of Part.
+func (s *OrderItem) GetPart() OptPartSummary {
+	return s.Part
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetPartName sets the value of PartName.
+func (s *OrderItem) SetPartName(val string) {
+	s.PartName = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPriceMinor sets the value of UnitPriceMinor.
+func (s *OrderItem) SetUnitPriceMinor(val int64) {
+	s.UnitPriceMinor = val
+}
+
+// SetLineTotalMinor sets the value of LineTotalMinor.
+func (s *OrderItem) SetLineTotalMinor(val int64) {
+	s.LineTotalMinor = val
+}
+
+// SetDiscountMinor sets the value of DiscountMinor.
+func (s *OrderItem) SetDiscountMinor(val int64) {
+	s.DiscountMinor = val
+}
+
+// SetTaxMinor sets the value of TaxMinor.
+func (s *OrderItem) SetTaxMinor(val int64) {
+	s.TaxMinor = val
+}
+
+// SetRefundedQuantity sets the value of RefundedQuantity.
+func (s *OrderItem) SetRefundedQuantity(val int64) {
+	s.RefundedQuantity = val
+}
+
+// SetPart sets the value of Part.
+func (s *OrderItem) SetPart(val OptPartSummary) {
+	s.Part = val
+}
+
+// Ref: #
+type OrderItemRequest struct {
+	PartUUID PartUUID `json:"part_uuid"`
+	Quantity Quantity `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemRequest) GetPartUUID() PartUUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemRequest) GetQuantity() Quantity {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemRequest) SetPartUUID(val PartUUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemRequest) SetQuantity(val Quantity) {
+	s.Quantity = val
+}
+
+// Ref: #
+type OrderItemUpdate struct {
+	PartUUID PartUUID `json:"part_uuid"`
+	// Новое количество деталей, 0 удаляет позицию.
+	Quantity int64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItemUpdate) GetPartUUID() PartUUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItemUpdate) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItemUpdate) SetPartUUID(val PartUUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItemUpdate) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// Ref: #
+type OrderListResponse struct {
+	// Заказы на странице.
+	Orders []Order `json:"orders"`
+	// Раскрытия, выполненные не полностью хотя бы для
+	// одного заказа на странице.
+	ExpandPartial []OrderExpand `json:"expand_partial"`
+	// Курсор следующей страницы, отсутствует на последней
+	// странице.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetOrders returns the value of Orders.
+func (s *OrderListResponse) GetOrders() []Order {
+	return s.Orders
+}
+
+// GetExpandPartial returns the value of ExpandPartial.
+func (s *OrderListResponse) GetExpandPartial() []OrderExpand {
+	return s.ExpandPartial
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *OrderListResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetOrders sets the value of Orders.
+func (s *OrderListResponse) SetOrders(val []Order) {
+	s.Orders = val
+}
+
+// SetExpandPartial sets the value of ExpandPartial.
+func (s *OrderListResponse) SetExpandPartial(val []OrderExpand) {
+	s.ExpandPartial = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *OrderListResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*OrderListResponse) listOrdersRes() {}
+
+// Оплата всей суммы одним способом (payment_method) или
+// несколькими частями (tenders).
+// Указывается ровно одно из полей, сумма частей должна
+// совпадать с итоговой суммой заказа.
+// Ref: #
+type OrderPayRequest struct {
+	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// Части оплаты в порядке списания.
+	Tenders []OrderTender `json:"tenders"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *OrderPayRequest) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetTenders returns the value of Tenders.
+func (s *OrderPayRequest) GetTenders() []OrderTender {
+	return s.Tenders
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *OrderPayRequest) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetTenders sets the value of Tenders.
+func (s *OrderPayRequest) SetTenders(val []OrderTender) {
+	s.Tenders = val
+}
+
+// Ref: #
+type OrderPayResponse struct {
+	// UUID транзакции первой части оплаты.
+	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+	// UUID транзакций всех частей оплаты в порядке списания.
+	TransactionUuids []uuid.UUID `json:"transaction_uuids"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *OrderPayResponse) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// GetTransactionUuids returns the value of TransactionUuids.
+func (s *OrderPayResponse) GetTransactionUuids() []uuid.UUID {
+	return s.TransactionUuids
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *OrderPayResponse) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+// SetTransactionUuids sets the value of TransactionUuids.
+func (s *OrderPayResponse) SetTransactionUuids(val []uuid.UUID) {
+	s.TransactionUuids = val
+}
+
+// OrderPayResponseHeaders wraps OrderPayResponse with response headers.
+type OrderPayResponseHeaders struct {
+	Etag     OptString
+	Response OrderPayResponse
+}
+
+// GetEtag returns the value of Etag.
+func (s *OrderPayResponseHeaders) GetEtag() OptString {
+	return s.Etag
+}
+
+// GetResponse returns the value of Response.
+func (s *OrderPayResponseHeaders) GetResponse() OrderPayResponse {
+	return s.Response
+}
+
+// SetEtag sets the value of Etag.
+func (s *OrderPayResponseHeaders) SetEtag(val OptString) {
+	s.Etag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *OrderPayResponseHeaders) SetResponse(val OrderPayResponse) {
+	s.Response = val
+}
+
+func (*OrderPayResponseHeaders) payOrderRes() {}
+
+// Оплаченная часть заказа.
+// Ref: #
+type OrderPayment struct {
+	// UUID транзакции оплаты.
+	TransactionUUID uuid.UUID     `json:"transaction_uuid"`
+	PaymentMethod   PaymentMethod `json:"payment_method"`
+	// Оплаченная сумма в копейках.
+	AmountMinor int64 `json:"amount_minor"`
+	// Сумма возвратов по этой оплате в копейках.
+	RefundedMinor int64 `json:"refunded_minor"`
+	// Время оплаты.
+	PaidAt time.Time `json:"paid_at"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *OrderPayment) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *OrderPayment) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetAmountMinor returns the value of AmountMinor.
+func (s *OrderPayment) GetAmountMinor() int64 {
+	return s.AmountMinor
+}
+
+// GetRefundedMinor returns the value of RefundedMinor.
+func (s *OrderPayment) GetRefundedMinor() int64 {
+	return s.RefundedMinor
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *OrderPayment) GetPaidAt() time.Time {
+	return s.PaidAt
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *OrderPayment) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *OrderPayment) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetAmountMinor sets the value of AmountMinor.
+func (s *OrderPayment) SetAmountMinor(val int64) {
+	s.AmountMinor = val
+}
+
+// SetRefundedMinor sets the value of RefundedMinor.
+func (s *OrderPayment) SetRefundedMinor(val int64) {
+	s.RefundedMinor = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *OrderPayment) SetPaidAt(val time.Time) {
+	s.PaidAt = val
+}
+
+// Ref: #
+type OrderRefund struct {
+	// UUID транзакции возврата.
+	RefundTransactionUUID uuid.UUID `json:"refund_transaction_uuid"`
+	// Сумма возврата в копейках.
+	AmountMinor int64 `json:"amount_minor"`
+	// Возвращённые на склад позиции, пусто для денежного
+	// возврата.
+	Items []OrderItemRequest `json:"items"`
+	// Причина возврата.
+	Reason string `json:"reason"`
+	// Время возврата.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetRefundTransactionUUID returns the value of RefundTransactionUUID.
+func (s *OrderRefund) GetRefundTransactionUUID() uuid.UUID {
+	return s.RefundTransactionUUID
+}
+
+// GetAmountMinor returns the value of AmountMinor.
+func (s *OrderRefund) GetAmountMinor() int64 {
+	return s.AmountMinor
+}
+
+// GetItems returns the value of Items.
+func (s *OrderRefund) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderRefund) GetReason() string {
+	return s.Reason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderRefund) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetRefundTransactionUUID sets the value of RefundTransactionUUID.
+func (s *OrderRefund) SetRefundTransactionUUID(val uuid.UUID) {
+	s.RefundTransactionUUID = val
+}
+
+// SetAmountMinor sets the value of AmountMinor.
+func (s *OrderRefund) SetAmountMinor(val int64) {
+	s.AmountMinor = val
+}
+
+// SetItems sets the value of Items.
+func (s *OrderRefund) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderRefund) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderRefund) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Без items и amount_minor возвращается весь остаток суммы
+// заказа и все невозвращённые позиции.
+// С items возвращаются указанные позиции по цене заказа, с
+// amount_minor — только деньги.
+// Возврат последних невозвращённых позиций возвращает
+// весь остаток суммы заказа, включая доставку.
+// Ref: #
+type OrderRefundRequest struct {
+	// Возвращаемые позиции.
+	Items []OrderItemRequest `json:"items"`
+	// Сумма денежного возврата в копейках.
+	AmountMinor OptInt64 `json:"amount_minor"`
+	// Причина возврата.
+	Reason OptString `json:"reason"`
+}
+
+// GetItems returns the value of Items.
+func (s *OrderRefundRequest) GetItems() []OrderItemRequest {
+	return s.Items
+}
+
+// GetAmountMinor returns the value of AmountMinor.
+func (s *OrderRefundRequest) GetAmountMinor() OptInt64 {
+	return s.AmountMinor
+}
+
+// GetReason returns the value of Reason.
+func (s *OrderRefundRequest) GetReason() OptString {
+	return s.Reason
+}
+
+// SetItems sets the value of Items.
+func (s *OrderRefundRequest) SetItems(val []OrderItemRequest) {
+	s.Items = val
+}
+
+// SetAmountMinor sets the value of AmountMinor.
+func (s *OrderRefundRequest) SetAmountMinor(val OptInt64) {
+	s.AmountMinor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OrderRefundRequest) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Поле сортировки заказов.
+// Ref: #
+type OrderSortBy string
+
+const (
+	OrderSortByCreatedAt       OrderSortBy = "created_at"
+	OrderSortByTotalPriceMinor OrderSortBy = "total_price_minor"
+)
+
+// AllValues returns all OrderSortBy values.
+func (OrderSortBy) AllValues() []OrderSortBy {
+	return []OrderSortBy{
+		OrderSortByCreatedAt,
+		OrderSortByTotalPriceMinor,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderSortBy) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderSortByCreatedAt:
+		return []byte(s), nil
+	case OrderSortByTotalPriceMinor:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderSortBy) UnmarshalText(data []byte) error {
+	switch OrderSortBy(data) {
+	case OrderSortByCreatedAt:
+		*s = OrderSortByCreatedAt
+		return nil
+	case OrderSortByTotalPriceMinor:
+		*s = OrderSortByTotalPriceMinor
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Статус заказа.
+// Ref: #
+type OrderStatus string
+
+const (
+	OrderStatusSTATUSPENDINGPAYMENT    OrderStatus = "STATUS_PENDING_PAYMENT"
+	OrderStatusSTATUSPARTIALLYPAID     OrderStatus = "STATUS_PARTIALLY_PAID"
+	OrderStatusSTATUSPAID              OrderStatus = "STATUS_PAID"
+	OrderStatusSTATUSCANCELLED         OrderStatus = "STATUS_CANCELLED"
+	OrderStatusSTATUSPARTIALLYREFUNDED OrderStatus = "STATUS_PARTIALLY_REFUNDED"
+	OrderStatusSTATUSREFUNDED          OrderStatus = "STATUS_REFUNDED"
+)
+
+// AllValues returns all OrderStatus values.
+func (OrderStatus) AllValues() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusSTATUSPENDINGPAYMENT,
+		OrderStatusSTATUSPARTIALLYPAID,
+		OrderStatusSTATUSPAID,
+		OrderStatusSTATUSCANCELLED,
+		OrderStatusSTATUSPARTIALLYREFUNDED,
+		OrderStatusSTATUSREFUNDED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderStatusSTATUSPENDINGPAYMENT:
+		return []byte(s), nil
+	case OrderStatusSTATUSPARTIALLYPAID:
+		return []byte(s), nil
+	case OrderStatusSTATUSPAID:
+		return []byte(s), nil
+	case OrderStatusSTATUSCANCELLED:
+		return []byte(s), nil
+	case OrderStatusSTATUSPARTIALLYREFUNDED:
+		return []byte(s), nil
+	case OrderStatusSTATUSREFUNDED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderStatus) UnmarshalText(data []byte) error {
+	switch OrderStatus(data) {
+	case OrderStatusSTATUSPENDINGPAYMENT:
+		*s = OrderStatusSTATUSPENDINGPAYMENT
+		return nil
+	case OrderStatusSTATUSPARTIALLYPAID:
+		*s = OrderStatusSTATUSPARTIALLYPAID
+		return nil
+	case OrderStatusSTATUSPAID:
+		*s = OrderStatusSTATUSPAID
+		return nil
+	case OrderStatusSTATUSCANCELLED:
+		*s = OrderStatusSTATUSCANCELLED
+		return nil
+	case OrderStatusSTATUSPARTIALLYREFUNDED:
+		*s = OrderStatusSTATUSPARTIALLYREFUNDED
+		return nil
+	case OrderStatusSTATUSREFUNDED:
+		*s = OrderStatusSTATUSREFUNDED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Часть оплаты заказа одним способом.
+// Ref: #
+type OrderTender struct {
+	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Сумма части оплаты в копейках.
+	AmountMinor int64 `json:"amount_minor"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *OrderTender) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetAmountMinor returns the value of AmountMinor.
+func (s *OrderTender) GetAmountMinor() int64 {
+	return s.AmountMinor
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *OrderTender) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetAmountMinor sets the value of AmountMinor.
+func (s *OrderTender) SetAmountMinor(val int64) {
+	s.AmountMinor = val
+}
+
+type OrderUUID uuid.UUID
+
+// Задаёт новое количество указанных деталей, остальные
+// позиции заказа не меняются.
+// Количество 0 удаляет деталь из заказа, деталь не из
+// заказа добавляется.
+// Ref: #
+type OrderUpdateRequest struct {
+	// Изменяемые позиции.
+	Items []OrderItemUpdate `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *OrderUpdateRequest) GetItems() []OrderItemUpdate {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *OrderUpdateRequest) SetItems(val []OrderItemUpdate) {
+	s.Items = val
+}
+
+// Категория детали.
+// Ref: #
+type PartCategory string
+
+const (
+	PartCategoryCATEGORYENGINE   PartCategory = "CATEGORY_ENGINE"
+	PartCategoryCATEGORYFUEL     PartCategory = "CATEGORY_FUEL"
+	PartCategoryCATEGORYPORTHOLE PartCategory = "CATEGORY_PORTHOLE"
+	PartCategoryCATEGORYWING     PartCategory = "CATEGORY_WING"
+)
+
+// AllValues returns all PartCategory values.
+func (PartCategory) AllValues() []PartCategory {
+	return []PartCategory{
+		PartCategoryCATEGORYENGINE,
+		PartCategoryCATEGORYFUEL,
+		PartCategoryCATEGORYPORTHOLE,
+		PartCategoryCATEGORYWING,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartCategory) MarshalText() ([]byte, error) {
+	switch s {
+	case PartCategoryCATEGORYENGINE:
+		return []byte(s), nil
+	case PartCategoryCATEGORYFUEL:
+		return []byte(s), nil
+	case PartCategoryCATEGORYPORTHOLE:
+		return []byte(s), nil
+	case PartCategoryCATEGORYWING:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartCategory) UnmarshalText(data []byte) error {
+	switch PartCategory(data) {
+	case PartCategoryCATEGORYENGINE:
+		*s = PartCategoryCATEGORYENGINE
+		return nil
+	case PartCategoryCATEGORYFUEL:
+		*s = PartCategoryCATEGORYFUEL
+		return nil
+	case PartCategoryCATEGORYPORTHOLE:
+		*s = PartCategoryCATEGORYPORTHOLE
+		return nil
+	case PartCategoryCATEGORYWING:
+		*s = PartCategoryCATEGORYWING
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Текущие сведения о детали со склада.
+// Ref: #
+type PartSummary struct {
+	// UUID детали.
+	UUID uuid.UUID `json:"uuid"`
+	// Название детали.
+	Name string `json:"name"`
+	// Категория детали.
+	Category OptPartCategory `json:"category"`
+	// Текущая цена за единицу в копейках.
+	PriceMinor int64 `json:"price_minor"`
+	// Производитель детали.
+	Manufacturer OptString `json:"manufacturer"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *PartSummary) GetUUID() uuid.UUID {
+	return s.UUID
+}
+
+// GetName returns the value of Name.
+func (s *PartSummary) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *PartSummary) GetCategory() OptPartCategory {
+	return s.Category
+}
+
+// GetPriceMinor returns the value of PriceMinor.
+func (s *PartSummary) GetPriceMinor() int64 {
+	return s.PriceMinor
+}
+
+// GetManufacturer returns the value of Manufacturer.
+func (s *PartSummary) GetManufacturer() OptString {
+	return s.Manufacturer
+}
+
+// SetUUID sets the value of UUID.
+func (s *PartSummary) SetUUID(val uuid.UUID) {
+	s.UUID = val
+}
+
+// SetName sets the value of Name.
+func (s *PartSummary) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *PartSummary) SetCategory(val OptPartCategory) {
+	s.Category = val
+}
+
+// SetPriceMinor sets the value of PriceMinor.
+func (s *PartSummary) SetPriceMinor(val int64) {
+	s.PriceMinor = val
+}
+
+// SetManufacturer sets the value of Manufacturer.
+func (s *PartSummary) SetManufacturer(val OptString) {
+	s.Manufacturer = val
+}
+
+type PartUUID uuid.UUID
+
+// Конфликт при оплате из-за изменения деталей заказа на
+// складе, changes перечисляет изменения.
+// Если заказ пересчитан по текущим ценам и ждёт
+// повторного подтверждения оплатой, order содержит
+// пересчитанный заказ.
+// Ref: #
+type PayConflictError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+	// Изменения деталей заказа.
+	Changes []ItemChange `json:"changes"`
+	// Заказ, пересчитанный по текущим ценам.
+	Order OptOrder `json:"order"`
+}
+
+// GetCode returns the value of Code.
+func (s *PayConflictError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PayConflictError) GetMessage() string {
+	return s.Message
+}
+
+// GetChanges returns the value of Changes.
+func (s *PayConflictError) GetChanges() []ItemChange {
+	return s.Changes
+}
+
+// GetOrder returns the value of Order.
+func (s *PayConflictError) GetOrder() OptOrder {
+	return s.Order
+}
+
+// SetCode sets the value of Code.
+func (s *PayConflictError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PayConflictError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *PayConflictError) SetChanges(val []ItemChange) {
+	s.Changes = val
+}
+
+// SetOrder sets the value of Order.
+func (s *PayConflictError) SetOrder(val OptOrder) {
+	s.Order = val
+}
+
+// PayOrderConflict represents sum type.
+type PayOrderConflict struct {
+	Type             PayOrderConflictType // switch on this field
+	ConflictError    ConflictError
+	PayConflictError PayConflictError
+}
+
+// PayOrderConflictType is oneOf type of PayOrderConflict.
+type PayOrderConflictType string
+
+// Possible values for PayOrderConflictType.
+const (
+	ConflictErrorPayOrderConflict    PayOrderConflictType = "ConflictError"
+	PayConflictErrorPayOrderConflict PayOrderConflictType = "PayConflictError"
+)
+
+// IsConflictError reports whether PayOrderConflict is ConflictError.
+func (s PayOrderConflict) IsConflictError() bool { return s.Type == ConflictErrorPayOrderConflict }
+
+// IsPayConflictError reports whether PayOrderConflict is PayConflictError.
+func (s PayOrderConflict) IsPayConflictError() bool {
+	return s.Type == PayConflictErrorPayOrderConflict
+}
+
+// SetConflictError sets PayOrderConflict to ConflictError.
+func (s *PayOrderConflict) SetConflictError(v ConflictError) {
+	s.Type = ConflictErrorPayOrderConflict
+	s.ConflictError = v
+}
+
+// GetConflictError returns ConflictError and true boolean if PayOrderConflict is ConflictError.
+func (s PayOrderConflict) GetConflictError() (v ConflictError, ok bool) {
+	if !s.IsConflictError() {
+		return v, false
+	}
+	return s.ConflictError, true
+}
+
+// NewConflictErrorPayOrderConflict returns new PayOrderConflict from ConflictError.
+func NewConflictErrorPayOrderConflict(v ConflictError) PayOrderConflict {
+	var s PayOrderConflict
+	s.SetConflictError(v)
+	return s
+}
+
+// SetPayConflictError sets PayOrderConflict to PayConflictError.
+func (s *PayOrderConflict) SetPayConflictError(v PayConflictError) {
+	s.Type = PayConflictErrorPayOrderConflict
+	s.PayConflictError = v
+}
+
+// GetPayConflictError returns PayConflictError and true boolean if PayOrderConflict is PayConflictError.
+func (s PayOrderConflict) GetPayConflictError() (v PayConflictError, ok bool) {
+	if !s.IsPayConflictError() {
+		return v, false
+	}
+	return s.PayConflictError, true
+}
+
+// NewPayConflictErrorPayOrderConflict returns new PayOrderConflict from PayConflictError.
+func NewPayConflictErrorPayOrderConflict(v PayConflictError) PayOrderConflict {
+	var s PayOrderConflict
+	s.SetPayConflictError(v)
+	return s
+}
+
+func (*PayOrderConflict) payOrderRes() {}
+
+// Способ оплаты.
+// Ref: #
+type PaymentMethod string
+
+const (
+	PaymentMethodPAYMENTMETHODUNSPECIFIED   PaymentMethod = "PAYMENT_METHOD_UNSPECIFIED"
+	PaymentMethodPAYMENTMETHODCARD          PaymentMethod = "PAYMENT_METHOD_CARD"
+	PaymentMethodPAYMENTMETHODSBP           PaymentMethod = "PAYMENT_METHOD_SBP"
+	PaymentMethodPAYMENTMETHODCREDITCARD    PaymentMethod = "PAYMENT_METHOD_CREDIT_CARD"
+	PaymentMethodPAYMENTMETHODINVESTORMONEY PaymentMethod = "PAYMENT_METHOD_INVESTOR_MONEY"
+)
+
+// AllValues returns all PaymentMethod values.
+func (PaymentMethod) AllValues() []PaymentMethod {
+	return []PaymentMethod{
+		PaymentMethodPAYMENTMETHODUNSPECIFIED,
+		PaymentMethodPAYMENTMETHODCARD,
+		PaymentMethodPAYMENTMETHODSBP,
+		PaymentMethodPAYMENTMETHODCREDITCARD,
+		PaymentMethodPAYMENTMETHODINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case PaymentMethodPAYMENTMETHODUNSPECIFIED:
+		return []byte(s), nil
+	case PaymentMethodPAYMENTMETHODCARD:
+		return []byte(s), nil
+	case PaymentMethodPAYMENTMETHODSBP:
+		return []byte(s), nil
+	case PaymentMethodPAYMENTMETHODCREDITCARD:
+		return []byte(s), nil
+	case PaymentMethodPAYMENTMETHODINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PaymentMethod) UnmarshalText(data []byte) error {
+	switch PaymentMethod(data) {
+	case PaymentMethodPAYMENTMETHODUNSPECIFIED:
+		*s = PaymentMethodPAYMENTMETHODUNSPECIFIED
+		return nil
+	case PaymentMethodPAYMENTMETHODCARD:
+		*s = PaymentMethodPAYMENTMETHODCARD
+		return nil
+	case PaymentMethodPAYMENTMETHODSBP:
+		*s = PaymentMethodPAYMENTMETHODSBP
+		return nil
+	case PaymentMethodPAYMENTMETHODCREDITCARD:
+		*s = PaymentMethodPAYMENTMETHODCREDITCARD
+		return nil
+	case PaymentMethodPAYMENTMETHODINVESTORMONEY:
+		*s = PaymentMethodPAYMENTMETHODINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PercentOff int64
+
+// Ref: #
+type PreconditionFailedError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *PreconditionFailedError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *PreconditionFailedError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *PreconditionFailedError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PreconditionFailedError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*PreconditionFailedError) cancelOrderRes() {}
+func (*PreconditionFailedError) payOrderRes()    {}
+func (*PreconditionFailedError) refundOrderRes() {}
+func (*PreconditionFailedError) updateOrderRes() {}
+
+// Промокод. Скидка применяется к позициям разрешённых
+// категорий и считается в целых копейках:
+// - процентная скидка считается по каждой позиции как
+// line_total_minor * percent_off / 100 с округлением вниз;
+// - фиксированная скидка ограничена суммой подходящих
+// позиций и распределяется по ним пропорционально
+// стоимости с округлением вниз, остаток копеек
+// добавляется к позициям по порядку.
+// Ref: #
+type PromoCode struct {
+	// Код, регистр не учитывается и приводится к верхнему.
+	Code string        `json:"code"`
+	Kind PromoCodeKind `json:"kind"`
+	// Процент скидки, для PROMO_CODE_KIND_PERCENTAGE.
+	PercentOff OptInt64 `json:"percent_off"`
+	// Сумма скидки в копейках, для PROMO_CODE_KIND_FIXED_AMOUNT.
+	AmountOffMinor OptInt64 `json:"amount_off_minor"`
+	// Начало действия промокода.
+	ValidFrom time.Time `json:"valid_from"`
+	// Окончание действия промокода, отсутствует для
+	// бессрочных.
+	ValidUntil OptDateTime `json:"valid_until"`
+	// Максимальное число заказов с промокодом, отсутствует
+	// если не ограничено.
+	MaxUses OptInt64 `json:"max_uses"`
+	// Максимальное число заказов с промокодом на
+	// пользователя, отсутствует если не ограничено.
+	MaxUsesPerUser OptInt64 `json:"max_uses_per_user"`
+	// Минимальная сумма заказа до скидки в копейках.
+	MinOrderTotalMinor OptInt64 `json:"min_order_total_minor"`
+	// Категории деталей, к которым применяется скидка,
+	// пусто для всех.
+	Categories []PartCategory `json:"categories"`
+	// Число неотменённых заказов с промокодом.
+	UsedCount int64 `json:"used_count"`
+	// Время создания промокода.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetCode returns the value of Code.
+func (s *PromoCode) GetCode() string {
+	return s.Code
+}
+
+// GetKind returns the value of Kind.
+func (s *PromoCode) GetKind() PromoCodeKind {
+	return s.Kind
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *OrderCreateResponse) SetOrderUUID(val OrderUUID) {
-	s.OrderUUID = val
+// GetPercentOff returns the value of PercentOff.
+func (s *PromoCode) GetPercentOff() OptInt64 {
+	return s.PercentOff
 }
 
-// SetTotalPriceMinor sets the value of TotalPriceMinor.
-func (s *OrderCreateResponse) SetTotalPriceMinor(val TotalPriceMinor) {
-	s.TotalPriceMinor = val
+// GetAmountOffMinor returns the value of AmountOffMinor.
+func (s *PromoCode) GetAmountOffMinor() OptInt64 {
+	return s.AmountOffMinor
 }
 
-func (*OrderCreateResponse) createOrderRes() {}
+// GetValidFrom returns the value of ValidFrom.
+func (s *PromoCode) GetValidFrom() time.Time {
+	return s.ValidFrom
+}
+
+// GetValidUntil returns the value of ValidUntil.
+func (s *PromoCode) GetValidUntil() OptDateTime {
+	return s.ValidUntil
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *PromoCode) GetMaxUses() OptInt64 {
+	return s.MaxUses
+}
+
+// GetMaxUsesPerUser returns the value of MaxUsesPerUser.
+func (s *PromoCode) GetMaxUsesPerUser() OptInt64 {
+	return s.MaxUsesPerUser
+}
+
+// GetMinOrderTotalMinor returns the value of MinOrderTotalMinor.
+func (s *PromoCode) GetMinOrderTotalMinor() OptInt64 {
+	return s.MinOrderTotalMinor
+}
+
+// GetCategories returns the value of Categories.
+func (s *PromoCode) GetCategories() []PartCategory {
+	return s.Categories
+}
+
+// GetUsedCount returns the value of UsedCount.
+func (s *PromoCode) GetUsedCount() int64 {
+	return s.UsedCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PromoCode) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetCode sets the value of Code.
+func (s *PromoCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetKind sets the value of Kind.
+func (s *PromoCode) SetKind(val PromoCodeKind) {
+	s.Kind = val
+}
+
+// SetPercentOff sets the value of PercentOff.
+func (s *PromoCode) SetPercentOff(val OptInt64) {
+	s.PercentOff = val
+}
+
+// SetAmountOffMinor sets the value of AmountOffMinor.
+func (s *PromoCode) SetAmountOffMinor(val OptInt64) {
+	s.AmountOffMinor = val
+}
+
+// SetValidFrom sets the value of ValidFrom.
+func (s *PromoCode) SetValidFrom(val time.Time) {
+	s.ValidFrom = val
+}
+
+// SetValidUntil sets the value of ValidUntil.
+func (s *PromoCode) SetValidUntil(val OptDateTime) {
+	s.ValidUntil = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *PromoCode) SetMaxUses(val OptInt64) {
+	s.MaxUses = val
+}
+
+// SetMaxUsesPerUser sets the value of MaxUsesPerUser.
+func (s *PromoCode) SetMaxUsesPerUser(val OptInt64) {
+	s.MaxUsesPerUser = val
+}
+
+// SetMinOrderTotalMinor sets the value of MinOrderTotalMinor.
+func (s *PromoCode) SetMinOrderTotalMinor(val OptInt64) {
+	s.MinOrderTotalMinor = val
+}
+
+// SetCategories sets the value of Categories.
+func (s *PromoCode) SetCategories(val []PartCategory) {
+	s.Categories = val
+}
+
+// SetUsedCount sets the value of UsedCount.
+func (s *PromoCode) SetUsedCount(val int64) {
+	s.UsedCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PromoCode) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*PromoCode) createPromoCodeRes() {}
+func (*PromoCode) getPromoCodeRes()    {}
 
 // Ref: #
-type OrderPayRequest struct {
-	PaymentMethod PaymentMethod `json:"payment_method"`
+type PromoCodeCreateRequest struct {
+	Code           Code              `json:"code"`
+	Kind           PromoCodeKind     `json:"kind"`
+	PercentOff     OptPercentOff     `json:"percent_off"`
+	AmountOffMinor OptAmountOffMinor `json:"amount_off_minor"`
+	// Начало действия промокода, по умолчанию время
+	// создания.
+	ValidFrom          OptDateTime           `json:"valid_from"`
+	ValidUntil         OptValidUntil         `json:"valid_until"`
+	MaxUses            OptMaxUses            `json:"max_uses"`
+	MaxUsesPerUser     OptMaxUsesPerUser     `json:"max_uses_per_user"`
+	MinOrderTotalMinor OptMinOrderTotalMinor `json:"min_order_total_minor"`
+	// Категории деталей, к которым применяется скидка,
+	// пусто для всех.
+	Categories []PartCategory `json:"categories"`
+}
+
+// GetCode returns the value of Code.
+func (s *PromoCodeCreateRequest) GetCode() Code {
+	return s.Code
+}
+
+// GetKind returns the value of Kind.
+func (s *PromoCodeCreateRequest) GetKind() PromoCodeKind {
+	return s.Kind
+}
+
+// GetPercentOff returns the value of PercentOff.
+func (s *PromoCodeCreateRequest) GetPercentOff() OptPercentOff {
+	return s.PercentOff
+}
+
+// GetAmountOffMinor returns the value of AmountOffMinor.
+func (s *PromoCodeCreateRequest) GetAmountOffMinor() OptAmountOffMinor {
+	return s.AmountOffMinor
+}
+
+// GetValidFrom returns the value of ValidFrom.
+func (s *PromoCodeCreateRequest) GetValidFrom() OptDateTime {
+	return s.ValidFrom
+}
+
+// GetValidUntil returns the value of ValidUntil.
+func (s *PromoCodeCreateRequest) GetValidUntil() OptValidUntil {
+	return s.ValidUntil
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *PromoCodeCreateRequest) GetMaxUses() OptMaxUses {
+	return s.MaxUses
+}
+
+// GetMaxUsesPerUser returns the value of MaxUsesPerUser.
+func (s *PromoCodeCreateRequest) GetMaxUsesPerUser() OptMaxUsesPerUser {
+	return s.MaxUsesPerUser
+}
+
+// GetMinOrderTotalMinor returns the value of MinOrderTotalMinor.
+func (s *PromoCodeCreateRequest) GetMinOrderTotalMinor() OptMinOrderTotalMinor {
+	return s.MinOrderTotalMinor
+}
+
+// GetCategories returns the value of Categories.
+func (s *PromoCodeCreateRequest) GetCategories() []PartCategory {
+	return s.Categories
+}
+
+// SetCode sets the value of Code.
+func (s *PromoCodeCreateRequest) SetCode(val Code) {
+	s.Code = val
+}
+
+// SetKind sets the value of Kind.
+func (s *PromoCodeCreateRequest) SetKind(val PromoCodeKind) {
+	s.Kind = val
+}
+
+// SetPercentOff sets the value of PercentOff.
+func (s *PromoCodeCreateRequest) SetPercentOff(val OptPercentOff) {
+	s.PercentOff = val
+}
+
+// SetAmountOffMinor sets the value of AmountOffMinor.
+func (s *PromoCodeCreateRequest) SetAmountOffMinor(val OptAmountOffMinor) {
+	s.AmountOffMinor = val
+}
+
+// SetValidFrom sets the value of ValidFrom.
+func (s *PromoCodeCreateRequest) SetValidFrom(val OptDateTime) {
+	s.ValidFrom = val
+}
+
+// SetValidUntil sets the value of ValidUntil.
+func (s *PromoCodeCreateRequest) SetValidUntil(val OptValidUntil) {
+	s.ValidUntil = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *PromoCodeCreateRequest) SetMaxUses(val OptMaxUses) {
+	s.MaxUses = val
+}
+
+// SetMaxUsesPerUser sets the value of MaxUsesPerUser.
+func (s *PromoCodeCreateRequest) SetMaxUsesPerUser(val OptMaxUsesPerUser) {
+	s.MaxUsesPerUser = val
+}
+
+// SetMinOrderTotalMinor sets the value of MinOrderTotalMinor.
+func (s *PromoCodeCreateRequest) SetMinOrderTotalMinor(val OptMinOrderTotalMinor) {
+	s.MinOrderTotalMinor = val
+}
+
+// SetCategories sets the value of Categories.
+func (s *PromoCodeCreateRequest) SetCategories(val []PartCategory) {
+	s.Categories = val
+}
+
+// Тип скидки промокода, процент или фиксированная сумма.
+// Ref: #
+type PromoCodeKind string
+
+const (
+	PromoCodeKindPROMOCODEKINDPERCENTAGE  PromoCodeKind = "PROMO_CODE_KIND_PERCENTAGE"
+	PromoCodeKindPROMOCODEKINDFIXEDAMOUNT PromoCodeKind = "PROMO_CODE_KIND_FIXED_AMOUNT"
+)
+
+// AllValues returns all PromoCodeKind values.
+func (PromoCodeKind) AllValues() []PromoCodeKind {
+	return []PromoCodeKind{
+		PromoCodeKindPROMOCODEKINDPERCENTAGE,
+		PromoCodeKindPROMOCODEKINDFIXEDAMOUNT,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PromoCodeKind) MarshalText() ([]byte, error) {
+	switch s {
+	case PromoCodeKindPROMOCODEKINDPERCENTAGE:
+		return []byte(s), nil
+	case PromoCodeKindPROMOCODEKINDFIXEDAMOUNT:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PromoCodeKind) UnmarshalText(data []byte) error {
+	switch PromoCodeKind(data) {
+	case PromoCodeKindPROMOCODEKINDPERCENTAGE:
+		*s = PromoCodeKindPROMOCODEKINDPERCENTAGE
+		return nil
+	case PromoCodeKindPROMOCODEKINDFIXEDAMOUNT:
+		*s = PromoCodeKindPROMOCODEKINDFIXEDAMOUNT
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type PromoCodeListResponse struct {
+	// Промокоды в порядке создания.
+	PromoCodes []PromoCode `json:"promo_codes"`
+}
+
+// GetPromoCodes returns the value of PromoCodes.
+func (s *PromoCodeListResponse) GetPromoCodes() []PromoCode {
+	return s.PromoCodes
+}
+
+// SetPromoCodes sets the value of PromoCodes.
+func (s *PromoCodeListResponse) SetPromoCodes(val []PromoCode) {
+	s.PromoCodes = val
+}
+
+type Quantity int64
+
+// Измерение группировки продаж.
+// Ref: #
+type SalesDimension string
+
+const (
+	SalesDimensionDay           SalesDimension = "day"
+	SalesDimensionPaymentMethod SalesDimension = "payment_method"
+	SalesDimensionCategory      SalesDimension = "category"
+	SalesDimensionManufacturer  SalesDimension = "manufacturer"
+)
+
+// AllValues returns all SalesDimension values.
+func (SalesDimension) AllValues() []SalesDimension {
+	return []SalesDimension{
+		SalesDimensionDay,
+		SalesDimensionPaymentMethod,
+		SalesDimensionCategory,
+		SalesDimensionManufacturer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SalesDimension) MarshalText() ([]byte, error) {
+	switch s {
+	case SalesDimensionDay:
+		return []byte(s), nil
+	case SalesDimensionPaymentMethod:
+		return []byte(s), nil
+	case SalesDimensionCategory:
+		return []byte(s), nil
+	case SalesDimensionManufacturer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SalesDimension) UnmarshalText(data []byte) error {
+	switch SalesDimension(data) {
+	case SalesDimensionDay:
+		*s = SalesDimensionDay
+		return nil
+	case SalesDimensionPaymentMethod:
+		*s = SalesDimensionPaymentMethod
+		return nil
+	case SalesDimensionCategory:
+		*s = SalesDimensionCategory
+		return nil
+	case SalesDimensionManufacturer:
+		*s = SalesDimensionManufacturer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Отчет о продажах заказов, созданных в отчетном
+// периоде. Выручку приносят оплаченные заказы, в том
+// числе позже возвращенные.
+// Ref: #
+type SalesReportResponse struct {
+	// Начало отчетного периода включительно.
+	From time.Time `json:"from"`
+	// Конец отчетного периода, не включая.
+	To time.Time `json:"to"`
+	// Измерения группировки.
+	GroupBy []SalesDimension `json:"group_by"`
+	// Строки отчета, отсортированные по измерениям.
+	Rows []SalesReportRow `json:"rows"`
+	// Число созданных заказов.
+	OrdersCreated int64 `json:"orders_created"`
+	// Число оплаченных из них заказов.
+	OrdersPaid int64 `json:"orders_paid"`
+	// Доля оплаченных заказов среди созданных в базисных
+	// пунктах, 7500 означает 75%.
+	ConversionBasisPoints int64 `json:"conversion_basis_points"`
+}
+
+// GetFrom returns the value of From.
+func (s *SalesReportResponse) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *SalesReportResponse) GetTo() time.Time {
+	return s.To
+}
+
+// GetGroupBy returns the value of GroupBy.
+func (s *SalesReportResponse) GetGroupBy() []SalesDimension {
+	return s.GroupBy
+}
+
+// GetRows returns the value of Rows.
+func (s *SalesReportResponse) GetRows() []SalesReportRow {
+	return s.Rows
+}
+
+// GetOrdersCreated returns the value of OrdersCreated.
+func (s *SalesReportResponse) GetOrdersCreated() int64 {
+	return s.OrdersCreated
+}
+
+// GetOrdersPaid returns the value of OrdersPaid.
+func (s *SalesReportResponse) GetOrdersPaid() int64 {
+	return s.OrdersPaid
+}
+
+// GetConversionBasisPoints returns the value of ConversionBasisPoints.
+func (s *SalesReportResponse) GetConversionBasisPoints() int64 {
+	return s.ConversionBasisPoints
+}
+
+// SetFrom sets the value of From.
+func (s *SalesReportResponse) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *SalesReportResponse) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetGroupBy sets the value of GroupBy.
+func (s *SalesReportResponse) SetGroupBy(val []SalesDimension) {
+	s.GroupBy = val
+}
+
+// SetRows sets the value of Rows.
+func (s *SalesReportResponse) SetRows(val []SalesReportRow) {
+	s.Rows = val
+}
+
+// SetOrdersCreated sets the value of OrdersCreated.
+func (s *SalesReportResponse) SetOrdersCreated(val int64) {
+	s.OrdersCreated = val
+}
+
+// SetOrdersPaid sets the value of OrdersPaid.
+func (s *SalesReportResponse) SetOrdersPaid(val int64) {
+	s.OrdersPaid = val
+}
+
+// SetConversionBasisPoints sets the value of ConversionBasisPoints.
+func (s *SalesReportResponse) SetConversionBasisPoints(val int64) {
+	s.ConversionBasisPoints = val
+}
+
+func (*SalesReportResponse) getSalesReportRes() {}
+
+// Продажи одной группы. Заполнены только поля
+// измерений, по которым сгруппирован отчет.
+// Ref: #
+type SalesReportRow struct {
+	// День создания заказа в UTC.
+	Day OptDate `json:"day"`
+	// Способ оплаты, для раздельной оплаты способ первого
+	// платежа.
+	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// Категория детали, отсутствует для деталей, удаленных
+	// из склада.
+	Category OptPartCategory `json:"category"`
+	// Производитель детали, пустой для деталей, удаленных
+	// из склада.
+	Manufacturer OptString `json:"manufacturer"`
+	// Число оплаченных заказов с деталями группы.
+	OrdersPaid int64 `json:"orders_paid"`
+	// Число проданных деталей.
+	Units int64 `json:"units"`
+	// Выручка в копейках, стоимость деталей за вычетом
+	// скидок без налогов и доставки.
+	RevenueMinor int64 `json:"revenue_minor"`
+	// Часть выручки в копейках, приходящаяся на
+	// возвращенные детали.
+	RefundedMinor int64 `json:"refunded_minor"`
+}
+
+// GetDay returns the value of Day.
+func (s *SalesReportRow) GetDay() OptDate {
+	return s.Day
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *SalesReportRow) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetCategory returns the value of Category.
+func (s *SalesReportRow) GetCategory() OptPartCategory {
+	return s.Category
+}
+
+// GetManufacturer returns the value of Manufacturer.
+func (s *SalesReportRow) GetManufacturer() OptString {
+	return s.Manufacturer
+}
+
+// GetOrdersPaid returns the value of OrdersPaid.
+func (s *SalesReportRow) GetOrdersPaid() int64 {
+	return s.OrdersPaid
+}
+
+// GetUnits returns the value of Units.
+func (s *SalesReportRow) GetUnits() int64 {
+	return s.Units
+}
+
+// GetRevenueMinor returns the value of RevenueMinor.
+func (s *SalesReportRow) GetRevenueMinor() int64 {
+	return s.RevenueMinor
+}
+
+// GetRefundedMinor returns the value of RefundedMinor.
+func (s *SalesReportRow) GetRefundedMinor() int64 {
+	return s.RefundedMinor
+}
+
+// SetDay sets the value of Day.
+func (s *SalesReportRow) SetDay(val OptDate) {
+	s.Day = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *SalesReportRow) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetCategory sets the value of Category.
+func (s *SalesReportRow) SetCategory(val OptPartCategory) {
+	s.Category = val
+}
+
+// SetManufacturer sets the value of Manufacturer.
+func (s *SalesReportRow) SetManufacturer(val OptString) {
+	s.Manufacturer = val
+}
+
+// SetOrdersPaid sets the value of OrdersPaid.
+func (s *SalesReportRow) SetOrdersPaid(val int64) {
+	s.OrdersPaid = val
+}
+
+// SetUnits sets the value of Units.
+func (s *SalesReportRow) SetUnits(val int64) {
+	s.Units = val
+}
+
+// SetRevenueMinor sets the value of RevenueMinor.
+func (s *SalesReportRow) SetRevenueMinor(val int64) {
+	s.RevenueMinor = val
+}
+
+// SetRefundedMinor sets the value of RefundedMinor.
+func (s *SalesReportRow) SetRefundedMinor(val int64) {
+	s.RefundedMinor = val
+}
+
+// Ref: #
+type ServiceUnavailableError struct {
+	// HTTP-код ошибки.
+	Code int `json:"code"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *ServiceUnavailableError) GetCode() int {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ServiceUnavailableError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *ServiceUnavailableError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ServiceUnavailableError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*ServiceUnavailableError) createOrderRes()    {}
+func (*ServiceUnavailableError) getOrderByUuidRes() {}
+func (*ServiceUnavailableError) getSalesReportRes() {}
+func (*ServiceUnavailableError) payOrderRes()       {}
+func (*ServiceUnavailableError) refundOrderRes()    {}
+func (*ServiceUnavailableError) updateOrderRes()    {}
+
+// Ref: #
+type Shipment struct {
+	// Страна доставки, код ISO 3166-1 alpha-2.
+	Country string `json:"country"`
+	// Оплачиваемый вес в килограммах, наибольший из
+	// фактического и объёмного.
+	ChargeableWeightKg float64 `json:"chargeable_weight_kg"`
+	// Объём деталей в кубических сантиметрах.
+	VolumeCm3 float64 `json:"volume_cm3"`
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *OrderPayRequest) GetPaymentMethod() PaymentMethod {
-	return s.PaymentMethod
+// GetCountry returns the value of Country.
+func (s *Shipment) GetCountry() string {
+	return s.Country
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *OrderPayRequest) SetPaymentMethod(val PaymentMethod) {
-	s.PaymentMethod = val
+// GetChargeableWeightKg returns the value of ChargeableWeightKg.
+func (s *Shipment) GetChargeableWeightKg() float64 {
+	return s.ChargeableWeightKg
 }
 
-// Ref: #
-type OrderPayResponse struct {
-	// UUID транзакции.
-	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+// GetVolumeCm3 returns the value of VolumeCm3.
+func (s *Shipment) GetVolumeCm3() float64 {
+	return s.VolumeCm3
 }
 
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *OrderPayResponse) GetTransactionUUID() uuid.UUID {
-	return s.TransactionUUID
+// SetCountry sets the value of Country.
+func (s *Shipment) SetCountry(val string) {
+	s.Country = val
 }
 
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *OrderPayResponse) SetTransactionUUID(val uuid.UUID) {
-	s.TransactionUUID = val
+// SetChargeableWeightKg sets the value of ChargeableWeightKg.
+func (s *Shipment) SetChargeableWeightKg(val float64) {
+	s.ChargeableWeightKg = val
 }
 
-func (*OrderPayResponse) payOrderRes() {}
+// SetVolumeCm3 sets the value of VolumeCm3.
+func (s *Shipment) SetVolumeCm3(val float64) {
+	s.VolumeCm3 = val
+}
 
-// Статус заказа.
+// Направление сортировки.
 // Ref: #
-type OrderStatus string
+type SortOrder string
 
 const (
-	OrderStatusSTATUSPENDINGPAYMENT OrderStatus = "STATUS_PENDING_PAYMENT"
-	OrderStatusSTATUSPAID           OrderStatus = "STATUS_PAID"
-	OrderStatusSTATUSCANCELLED      OrderStatus = "STATUS_CANCELLED"
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
 )
 
-// AllValues returns all OrderStatus values.
-func (OrderStatus) AllValues() []OrderStatus {
-	return []OrderStatus{
-		OrderStatusSTATUSPENDINGPAYMENT,
-		OrderStatusSTATUSPAID,
-		OrderStatusSTATUSCANCELLED,
+// AllValues returns all SortOrder values.
+func (SortOrder) AllValues() []SortOrder {
+	return []SortOrder{
+		SortOrderAsc,
+		SortOrderDesc,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s OrderStatus) MarshalText() ([]byte, error) {
+func (s SortOrder) MarshalText() ([]byte, error) {
 	switch s {
-	case OrderStatusSTATUSPENDINGPAYMENT:
+	case SortOrderAsc:
 		return []byte(s), nil
-	case OrderStatusSTATUSPAID:
-		return []byte(s), nil
-	case OrderStatusSTATUSCANCELLED:
+	case SortOrderDesc:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -556,94 +3425,157 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *OrderStatus) UnmarshalText(data []byte) error {
-	switch OrderStatus(data) {
-	case OrderStatusSTATUSPENDINGPAYMENT:
-		*s = OrderStatusSTATUSPENDINGPAYMENT
-		return nil
-	case OrderStatusSTATUSPAID:
-		*s = OrderStatusSTATUSPAID
+func (s *SortOrder) UnmarshalText(data []byte) error {
+	switch SortOrder(data) {
+	case SortOrderAsc:
+		*s = SortOrderAsc
 		return nil
-	case OrderStatusSTATUSCANCELLED:
-		*s = OrderStatusSTATUSCANCELLED
+	case SortOrderDesc:
+		*s = SortOrderDesc
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type OrderUUID uuid.UUID
+// Ref: #
+type StatusTransition struct {
+	// Статус до перехода, отсутствует при создании заказа.
+	FromStatus OptOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus    `json:"to_status"`
+	// Время перехода.
+	At time.Time `json:"at"`
+	// Инициатор перехода, `user:<uuid>` или `system`.
+	Actor string `json:"actor"`
+	// Причина перехода.
+	Reason string `json:"reason"`
+}
+
+// GetFromStatus returns the value of FromStatus.
+func (s *StatusTransition) GetFromStatus() OptOrderStatus {
+	return s.FromStatus
+}
 
-type PartUuids []uuid.UUID
+// GetToStatus returns the value of ToStatus.
+func (s *StatusTransition) GetToStatus() OrderStatus {
+	return s.ToStatus
+}
 
-// Способ оплаты.
+// GetAt returns the value of At.
+func (s *StatusTransition) GetAt() time.Time {
+	return s.At
+}
+
+// GetActor returns the value of Actor.
+func (s *StatusTransition) GetActor() string {
+	return s.Actor
+}
+
+// GetReason returns the value of Reason.
+func (s *StatusTransition) GetReason() string {
+	return s.Reason
+}
+
+// SetFromStatus sets the value of FromStatus.
+func (s *StatusTransition) SetFromStatus(val OptOrderStatus) {
+	s.FromStatus = val
+}
+
+// SetToStatus sets the value of ToStatus.
+func (s *StatusTransition) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
+
+// SetAt sets the value of At.
+func (s *StatusTransition) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetActor sets the value of Actor.
+func (s *StatusTransition) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetReason sets the value of Reason.
+func (s *StatusTransition) SetReason(val string) {
+	s.Reason = val
+}
+
+// Налог, начисленный по одной ставке на детали одной
+// категории или на доставку.
 // Ref: #
-type PaymentMethod string
+type TaxLine struct {
+	// Категория облагаемых деталей, отсутствует для налога
+	// на доставку.
+	Category OptPartCategory `json:"category"`
+	// Налог начислен на стоимость доставки.
+	Shipping bool `json:"shipping"`
+	// Ставка в базисных пунктах, 2000 означает 20%.
+	RateBasisPoints int64 `json:"rate_basis_points"`
+	// Облагаемая сумма в копейках.
+	TaxableMinor int64 `json:"taxable_minor"`
+	// Сумма налога в копейках.
+	TaxMinor int64 `json:"tax_minor"`
+}
 
-const (
-	PaymentMethodPAYMENTMETHODUNSPECIFIED   PaymentMethod = "PAYMENT_METHOD_UNSPECIFIED"
-	PaymentMethodPAYMENTMETHODCARD          PaymentMethod = "PAYMENT_METHOD_CARD"
-	PaymentMethodPAYMENTMETHODSBP           PaymentMethod = "PAYMENT_METHOD_SBP"
-	PaymentMethodPAYMENTMETHODCREDITCARD    PaymentMethod = "PAYMENT_METHOD_CREDIT_CARD"
-	PaymentMethodPAYMENTMETHODINVESTORMONEY PaymentMethod = "PAYMENT_METHOD_INVESTOR_MONEY"
-)
+// GetCategory returns the value of Category.
+func (s *TaxLine) GetCategory() OptPartCategory {
+	return s.Category
+}
 
-// AllValues returns all PaymentMethod values.
-func (PaymentMethod) AllValues() []PaymentMethod {
-	return []PaymentMethod{
-		PaymentMethodPAYMENTMETHODUNSPECIFIED,
-		PaymentMethodPAYMENTMETHODCARD,
-		PaymentMethodPAYMENTMETHODSBP,
-		PaymentMethodPAYMENTMETHODCREDITCARD,
-		PaymentMethodPAYMENTMETHODINVESTORMONEY,
-	}
+// GetShipping returns the value of Shipping.
+func (s *TaxLine) GetShipping() bool {
+	return s.Shipping
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s PaymentMethod) MarshalText() ([]byte, error) {
-	switch s {
-	case PaymentMethodPAYMENTMETHODUNSPECIFIED:
-		return []byte(s), nil
-	case PaymentMethodPAYMENTMETHODCARD:
-		return []byte(s), nil
-	case PaymentMethodPAYMENTMETHODSBP:
-		return []byte(s), nil
-	case PaymentMethodPAYMENTMETHODCREDITCARD:
-		return []byte(s), nil
-	case PaymentMethodPAYMENTMETHODINVESTORMONEY:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetRateBasisPoints returns the value of RateBasisPoints.
+func (s *TaxLine) GetRateBasisPoints() int64 {
+	return s.RateBasisPoints
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PaymentMethod) UnmarshalText(data []byte) error {
-	switch PaymentMethod(data) {
-	case PaymentMethodPAYMENTMETHODUNSPECIFIED:
-		*s = PaymentMethodPAYMENTMETHODUNSPECIFIED
-		return nil
-	case PaymentMethodPAYMENTMETHODCARD:
-		*s = PaymentMethodPAYMENTMETHODCARD
-		return nil
-	case PaymentMethodPAYMENTMETHODSBP:
-		*s = PaymentMethodPAYMENTMETHODSBP
-		return nil
-	case PaymentMethodPAYMENTMETHODCREDITCARD:
-		*s = PaymentMethodPAYMENTMETHODCREDITCARD
-		return nil
-	case PaymentMethodPAYMENTMETHODINVESTORMONEY:
-		*s = PaymentMethodPAYMENTMETHODINVESTORMONEY
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetTaxableMinor returns the value of TaxableMinor.
+func (s *TaxLine) GetTaxableMinor() int64 {
+	return s.TaxableMinor
+}
+
+// GetTaxMinor returns the value of TaxMinor.
+func (s *TaxLine) GetTaxMinor() int64 {
+	return s.TaxMinor
+}
+
+// SetCategory sets the value of Category.
+func (s *TaxLine) SetCategory(val OptPartCategory) {
+	s.Category = val
+}
+
+// SetShipping sets the value of Shipping.
+func (s *TaxLine) SetShipping(val bool) {
+	s.Shipping = val
+}
+
+// SetRateBasisPoints sets the value of RateBasisPoints.
+func (s *TaxLine) SetRateBasisPoints(val int64) {
+	s.RateBasisPoints = val
+}
+
+// SetTaxableMinor sets the value of TaxableMinor.
+func (s *TaxLine) SetTaxableMinor(val int64) {
+	s.TaxableMinor = val
+}
+
+// SetTaxMinor sets the value of TaxMinor.
+func (s *TaxLine) SetTaxMinor(val int64) {
+	s.TaxMinor = val
 }
 
 type TotalPriceMinor int64
 
+type URL url.URL
+
 type UserUUID uuid.UUID
 
+type ValidUntil time.Time
+
 // Ref: #
 type ValidationError struct {
 	// HTTP-код ошибки.
@@ -672,6 +3604,337 @@ func (s *ValidationError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ValidationError) createOrderRes()    {}
-func (*ValidationError) getOrderByUuidRes() {}
-func (*ValidationError) payOrderRes()       {}
+func (*ValidationError) cancelOrderRes()     {}
+func (*ValidationError) createOrderRes()     {}
+func (*ValidationError) createPromoCodeRes() {}
+func (*ValidationError) createWebhookRes()   {}
+func (*ValidationError) getOrderByUuidRes()  {}
+func (*ValidationError) getSalesReportRes()  {}
+func (*ValidationError) listOrdersRes()      {}
+func (*ValidationError) payOrderRes()        {}
+func (*ValidationError) refundOrderRes()     {}
+func (*ValidationError) updateOrderRes()     {}
+
+// Подписка HTTP-эндпоинта на события заказов.
+// Ref: #
+type Webhook struct {
+	// UUID подписки.
+	WebhookUUID uuid.UUID `json:"webhook_uuid"`
+	// Адрес, на который события отправляются запросом POST.
+	URL url.URL `json:"url"`
+	// Типы событий подписки, пустой список означает все
+	// события.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Ключ подписи HMAC-SHA256, возвращается только при создании
+	// подписки.
+	Secret OptString `json:"secret"`
+	// Время создания подписки.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetWebhookUUID returns the value of WebhookUUID.
+func (s *Webhook) GetWebhookUUID() uuid.UUID {
+	return s.WebhookUUID
+}
+
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() url.URL {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetSecret returns the value of Secret.
+func (s *Webhook) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Webhook) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetWebhookUUID sets the value of WebhookUUID.
+func (s *Webhook) SetWebhookUUID(val uuid.UUID) {
+	s.WebhookUUID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *Webhook) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Webhook) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Webhook) createWebhookRes() {}
+func (*Webhook) getWebhookRes()    {}
+
+// Ref: #
+type WebhookCreateRequest struct {
+	URL URL `json:"url"`
+	// Типы событий подписки, по умолчанию все события.
+	EventTypes []WebhookEventType `json:"event_types"`
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookCreateRequest) GetURL() URL {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *WebhookCreateRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookCreateRequest) SetURL(val URL) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *WebhookCreateRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// Ref: #
+type WebhookDeadLetterListResponse struct {
+	// Недоставленные события, последние первыми.
+	DeadLetters []WebhookDelivery `json:"dead_letters"`
+}
+
+// GetDeadLetters returns the value of DeadLetters.
+func (s *WebhookDeadLetterListResponse) GetDeadLetters() []WebhookDelivery {
+	return s.DeadLetters
+}
+
+// SetDeadLetters sets the value of DeadLetters.
+func (s *WebhookDeadLetterListResponse) SetDeadLetters(val []WebhookDelivery) {
+	s.DeadLetters = val
+}
+
+// Доставка события в подписку, исчерпавшая попытки.
+// Ref: #
+type WebhookDelivery struct {
+	// UUID доставки.
+	DeliveryUUID uuid.UUID    `json:"delivery_uuid"`
+	WebhookUUID  WebhookUUID  `json:"webhook_uuid"`
+	Event        WebhookEvent `json:"event"`
+	// Количество неудачных попыток.
+	Attempts int64 `json:"attempts"`
+	// Ошибка последней попытки.
+	LastError string `json:"last_error"`
+	// Время, когда доставка была прекращена.
+	DeadAt time.Time `json:"dead_at"`
+}
+
+// GetDeliveryUUID returns the value of DeliveryUUID.
+func (s *WebhookDelivery) GetDeliveryUUID() uuid.UUID {
+	return s.DeliveryUUID
+}
+
+// GetWebhookUUID returns the value of WebhookUUID.
+func (s *WebhookDelivery) GetWebhookUUID() WebhookUUID {
+	return s.WebhookUUID
+}
+
+// GetEvent returns the value of Event.
+func (s *WebhookDelivery) GetEvent() WebhookEvent {
+	return s.Event
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int64 {
+	return s.Attempts
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() string {
+	return s.LastError
+}
+
+// GetDeadAt returns the value of DeadAt.
+func (s *WebhookDelivery) GetDeadAt() time.Time {
+	return s.DeadAt
+}
+
+// SetDeliveryUUID sets the value of DeliveryUUID.
+func (s *WebhookDelivery) SetDeliveryUUID(val uuid.UUID) {
+	s.DeliveryUUID = val
+}
+
+// SetWebhookUUID sets the value of WebhookUUID.
+func (s *WebhookDelivery) SetWebhookUUID(val WebhookUUID) {
+	s.WebhookUUID = val
+}
+
+// SetEvent sets the value of Event.
+func (s *WebhookDelivery) SetEvent(val WebhookEvent) {
+	s.Event = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int64) {
+	s.Attempts = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val string) {
+	s.LastError = val
+}
+
+// SetDeadAt sets the value of DeadAt.
+func (s *WebhookDelivery) SetDeadAt(val time.Time) {
+	s.DeadAt = val
+}
+
+// Событие заказа, тело запроса к эндпоинту подписки.
+// Событие может быть доставлено повторно
+// и не по порядку, повторы определяются по event_uuid.
+// Ref: #
+type WebhookEvent struct {
+	// UUID события, совпадает с заголовком X-Webhook-Id.
+	EventUUID uuid.UUID        `json:"event_uuid"`
+	Type      WebhookEventType `json:"type"`
+	// Заказ сразу после изменения.
+	Order Order `json:"order"`
+	// Время события.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetEventUUID returns the value of EventUUID.
+func (s *WebhookEvent) GetEventUUID() uuid.UUID {
+	return s.EventUUID
+}
+
+// GetType returns the value of Type.
+func (s *WebhookEvent) GetType() WebhookEventType {
+	return s.Type
+}
+
+// GetOrder returns the value of Order.
+func (s *WebhookEvent) GetOrder() Order {
+	return s.Order
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WebhookEvent) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetEventUUID sets the value of EventUUID.
+func (s *WebhookEvent) SetEventUUID(val uuid.UUID) {
+	s.EventUUID = val
+}
+
+// SetType sets the value of Type.
+func (s *WebhookEvent) SetType(val WebhookEventType) {
+	s.Type = val
+}
+
+// SetOrder sets the value of Order.
+func (s *WebhookEvent) SetOrder(val Order) {
+	s.Order = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WebhookEvent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Тип события заказа.
+// Ref: #
+type WebhookEventType string
+
+const (
+	WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED   WebhookEventType = "WEBHOOK_EVENT_TYPE_ORDER_CREATED"
+	WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED   WebhookEventType = "WEBHOOK_EVENT_TYPE_ORDER_UPDATED"
+	WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID      WebhookEventType = "WEBHOOK_EVENT_TYPE_ORDER_PAID"
+	WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED WebhookEventType = "WEBHOOK_EVENT_TYPE_ORDER_CANCELLED"
+	WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED  WebhookEventType = "WEBHOOK_EVENT_TYPE_ORDER_REFUNDED"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED,
+		WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED,
+		WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID,
+		WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED,
+		WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED:
+		return []byte(s), nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED:
+		return []byte(s), nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID:
+		return []byte(s), nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED:
+		return []byte(s), nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED:
+		*s = WebhookEventTypeWEBHOOKEVENTTYPEORDERCREATED
+		return nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED:
+		*s = WebhookEventTypeWEBHOOKEVENTTYPEORDERUPDATED
+		return nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID:
+		*s = WebhookEventTypeWEBHOOKEVENTTYPEORDERPAID
+		return nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED:
+		*s = WebhookEventTypeWEBHOOKEVENTTYPEORDERCANCELLED
+		return nil
+	case WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED:
+		*s = WebhookEventTypeWEBHOOKEVENTTYPEORDERREFUNDED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #
+type WebhookListResponse struct {
+	// Подписки в порядке создания.
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// GetWebhooks returns the value of Webhooks.
+func (s *WebhookListResponse) GetWebhooks() []Webhook {
+	return s.Webhooks
+}
+
+// SetWebhooks sets the value of Webhooks.
+func (s *WebhookListResponse) SetWebhooks(val []Webhook) {
+	s.Webhooks = val
+}
+
+type WebhookUUID uuid.UUID
@@ -24,31 +24,174 @@ func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderP
 
 // CreateOrder implements createOrder operation.
 //
-// Creates a new order.
+// Creates a new order from line items, snapshotting part names and prices. Requested quantities must
+// be in stock. An optional promo code discounts eligible lines. Shipping and VAT are added by the
+// destination country.
 //
 // POST /api/v1/orders
-func (UnimplementedHandler) CreateOrder(ctx context.Context, req *OrderCreateRequest) (r CreateOrderRes, _ error) {
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *OrderCreateRequest, params CreateOrderParams) (r CreateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreatePromoCode implements createPromoCode operation.
+//
+// Creates a percentage or fixed-amount promo code.
+//
+// POST /api/v1/promo-codes
+func (UnimplementedHandler) CreatePromoCode(ctx context.Context, req *PromoCodeCreateRequest, params CreatePromoCodeParams) (r CreatePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateWebhook implements createWebhook operation.
+//
+// Subscribes an HTTP endpoint to order events. The signing secret is returned only in this response.
+//
+// POST /api/v1/webhooks
+func (UnimplementedHandler) CreateWebhook(ctx context.Context, req *WebhookCreateRequest, params CreateWebhookParams) (r CreateWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeletePromoCode implements deletePromoCode operation.
+//
+// Deletes a promo code. Orders that already use it keep their discount.
+//
+// DELETE /api/v1/promo-codes/{code}
+func (UnimplementedHandler) DeletePromoCode(ctx context.Context, params DeletePromoCodeParams) (r DeletePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhook implements deleteWebhook operation.
+//
+// Deletes a webhook subscription and its pending deliveries. Dead letters are kept.
+//
+// DELETE /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) (r DeleteWebhookRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // GetOrderByUuid implements getOrderByUuid operation.
 //
-// Retrieves order details by UUID.
+// Retrieves order details by UUID. With `expand=parts` the items embed current part details fetched
+// from inventory; if inventory is unavailable the order is returned without them and
+// `expand_partial` lists the expansion.
 //
 // GET /api/v1/orders/{order_uuid}
 func (UnimplementedHandler) GetOrderByUuid(ctx context.Context, params GetOrderByUuidParams) (r GetOrderByUuidRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Retrieves every status transition of the order with its time, actor and reason.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPromoCode implements getPromoCode operation.
+//
+// Retrieves a promo code with its usage.
+//
+// GET /api/v1/promo-codes/{code}
+func (UnimplementedHandler) GetPromoCode(ctx context.Context, params GetPromoCodeParams) (r GetPromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetSalesReport implements getSalesReport operation.
+//
+// Aggregates orders created in the period into revenue grouped by the chosen dimensions, with
+// conversion of created orders to paid. Category and manufacturer are taken from inventory. The same
+// report is exported as CSV outside this specification at `GET /api/v1/reports/sales.csv` with the
+// same parameters.
+//
+// GET /api/v1/reports/sales
+func (UnimplementedHandler) GetSalesReport(ctx context.Context, params GetSalesReportParams) (r GetSalesReportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhook implements getWebhook operation.
+//
+// Retrieves a webhook subscription without its secret.
+//
+// GET /api/v1/webhooks/{webhook_uuid}
+func (UnimplementedHandler) GetWebhook(ctx context.Context, params GetWebhookParams) (r GetWebhookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListOrders implements listOrders operation.
+//
+// Lists orders matching filters, page by page. With `expand=parts` the items embed current part
+// details fetched from inventory in one batch for the page; if inventory is unavailable the orders
+// are returned without them and `expand_partial` lists the expansion.
+//
+// GET /api/v1/orders
+func (UnimplementedHandler) ListOrders(ctx context.Context, params ListOrdersParams) (r ListOrdersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPromoCodes implements listPromoCodes operation.
+//
+// Lists all promo codes in creation order.
+//
+// GET /api/v1/promo-codes
+func (UnimplementedHandler) ListPromoCodes(ctx context.Context) (r *PromoCodeListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeadLetters implements listWebhookDeadLetters operation.
+//
+// Lists events that could not be delivered after all retries, most recent first.
+//
+// GET /api/v1/webhooks/dead-letters
+func (UnimplementedHandler) ListWebhookDeadLetters(ctx context.Context) (r *WebhookDeadLetterListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhooks implements listWebhooks operation.
+//
+// Lists all webhook subscriptions in creation order.
+//
+// GET /api/v1/webhooks
+func (UnimplementedHandler) ListWebhooks(ctx context.Context) (r *WebhookListResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
-// Processes payment for an existing order.
+// Processes payment for an existing order, either fully with one payment method or split
+// into tenders charged in order. While tenders are charged the order is partially paid.
+// If a tender fails, the tenders already charged are refunded and the order returns to
+// pending payment.
+// The ordered parts are checked against inventory first. Discontinued and out of stock parts
+// fail the payment with the changes listed. Price changes are handled by the configured policy:
+// the order is paid at its original prices, the payment fails, or the order is repriced at
+// current prices and the payment fails until it is repeated to confirm the new total.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *OrderPayRequest, params PayOrderParams) (r PayOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// RefundOrder implements refundOrder operation.
+//
+// Refunds a paid order fully or partially and returns refunded parts to inventory.
+//
+// POST /api/v1/orders/{order_uuid}/refund
+func (UnimplementedHandler) RefundOrder(ctx context.Context, req *OrderRefundRequest, params RefundOrderParams) (r RefundOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrder implements updateOrder operation.
+//
+// Adds, removes or changes the quantity of parts of an order pending payment.
+// The parts are re-checked against inventory and the order is repriced at current prices.
+//
+// PATCH /api/v1/orders/{order_uuid}
+func (UnimplementedHandler) UpdateOrder(ctx context.Context, req *OrderUpdateRequest, params UpdateOrderParams) (r UpdateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *GenericErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,112 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Kind of a metadata Value.
+type ValueKind int32
+
+const (
+	ValueKind_VALUE_KIND_UNSPECIFIED ValueKind = 0
+	ValueKind_VALUE_KIND_STRING      ValueKind = 1
+	ValueKind_VALUE_KIND_INT64       ValueKind = 2
+	ValueKind_VALUE_KIND_DOUBLE      ValueKind = 3
+	ValueKind_VALUE_KIND_BOOL        ValueKind = 4
+)
+
+// Enum value maps for ValueKind.
+var (
+	ValueKind_name = map[int32]string{
+		0: "VALUE_KIND_UNSPECIFIED",
+		1: "VALUE_KIND_STRING",
+		2: "VALUE_KIND_INT64",
+		3: "VALUE_KIND_DOUBLE",
+		4: "VALUE_KIND_BOOL",
+	}
+	ValueKind_value = map[string]int32{
+		"VALUE_KIND_UNSPECIFIED": 0,
+		"VALUE_KIND_STRING":      1,
+		"VALUE_KIND_INT64":       2,
+		"VALUE_KIND_DOUBLE":      3,
+		"VALUE_KIND_BOOL":        4,
+	}
+)
+
+func (x ValueKind) Enum() *ValueKind {
+	p := new(ValueKind)
+	*p = x
+	return p
+}
+
+func (x ValueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (ValueKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x ValueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValueKind.Descriptor instead.
+func (ValueKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// Kind of the Attachment.
+type AttachmentKind int32
+
+const (
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	AttachmentKind_ATTACHMENT_KIND_IMAGE       AttachmentKind = 1
+	AttachmentKind_ATTACHMENT_KIND_DOCUMENT    AttachmentKind = 2
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_IMAGE",
+		2: "ATTACHMENT_KIND_DOCUMENT",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_IMAGE":       1,
+		"ATTACHMENT_KIND_DOCUMENT":    2,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
 // Category of the Part.
 type Category int32
 
@@ -62,11 +169,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -75,13 +182,167 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// Unit system used for dimensions.
+type UnitSystem int32
+
+const (
+	UnitSystem_UNIT_SYSTEM_UNSPECIFIED UnitSystem = 0
+	// Centimeters and kilograms.
+	UnitSystem_UNIT_SYSTEM_METRIC UnitSystem = 1
+	// Inches and pounds.
+	UnitSystem_UNIT_SYSTEM_IMPERIAL UnitSystem = 2
+)
+
+// Enum value maps for UnitSystem.
+var (
+	UnitSystem_name = map[int32]string{
+		0: "UNIT_SYSTEM_UNSPECIFIED",
+		1: "UNIT_SYSTEM_METRIC",
+		2: "UNIT_SYSTEM_IMPERIAL",
+	}
+	UnitSystem_value = map[string]int32{
+		"UNIT_SYSTEM_UNSPECIFIED": 0,
+		"UNIT_SYSTEM_METRIC":      1,
+		"UNIT_SYSTEM_IMPERIAL":    2,
+	}
+)
+
+func (x UnitSystem) Enum() *UnitSystem {
+	p := new(UnitSystem)
+	*p = x
+	return p
+}
+
+func (x UnitSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (UnitSystem) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x UnitSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnitSystem.Descriptor instead.
+func (UnitSystem) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// Unit of length.
+type LengthUnit int32
+
+const (
+	LengthUnit_LENGTH_UNIT_UNSPECIFIED LengthUnit = 0
+	LengthUnit_LENGTH_UNIT_CENTIMETER  LengthUnit = 1
+	LengthUnit_LENGTH_UNIT_INCH        LengthUnit = 2
+)
+
+// Enum value maps for LengthUnit.
+var (
+	LengthUnit_name = map[int32]string{
+		0: "LENGTH_UNIT_UNSPECIFIED",
+		1: "LENGTH_UNIT_CENTIMETER",
+		2: "LENGTH_UNIT_INCH",
+	}
+	LengthUnit_value = map[string]int32{
+		"LENGTH_UNIT_UNSPECIFIED": 0,
+		"LENGTH_UNIT_CENTIMETER":  1,
+		"LENGTH_UNIT_INCH":        2,
+	}
+)
+
+func (x LengthUnit) Enum() *LengthUnit {
+	p := new(LengthUnit)
+	*p = x
+	return p
+}
+
+func (x LengthUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LengthUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (LengthUnit) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x LengthUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LengthUnit.Descriptor instead.
+func (LengthUnit) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+// Unit of weight.
+type WeightUnit int32
+
+const (
+	WeightUnit_WEIGHT_UNIT_UNSPECIFIED WeightUnit = 0
+	WeightUnit_WEIGHT_UNIT_KILOGRAM    WeightUnit = 1
+	WeightUnit_WEIGHT_UNIT_POUND       WeightUnit = 2
+)
+
+// Enum value maps for WeightUnit.
+var (
+	WeightUnit_name = map[int32]string{
+		0: "WEIGHT_UNIT_UNSPECIFIED",
+		1: "WEIGHT_UNIT_KILOGRAM",
+		2: "WEIGHT_UNIT_POUND",
+	}
+	WeightUnit_value = map[string]int32{
+		"WEIGHT_UNIT_UNSPECIFIED": 0,
+		"WEIGHT_UNIT_KILOGRAM":    1,
+		"WEIGHT_UNIT_POUND":       2,
+	}
+)
+
+func (x WeightUnit) Enum() *WeightUnit {
+	p := new(WeightUnit)
+	*p = x
+	return p
+}
+
+func (x WeightUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WeightUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[5].Descriptor()
+}
+
+func (WeightUnit) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[5]
+}
+
+func (x WeightUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WeightUnit.Descriptor instead.
+func (WeightUnit) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
 }
 
 // Request to Get parts.
 type GetPartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Unit system of returned dimensions. Metric if unspecified.
+	UnitSystem    UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -108,44 +369,1583 @@ func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPartRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+// Response to Get parts.
+type GetPartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Request to List parts by filter.
+type ListPartsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Unit system of returned dimensions. Metric if unspecified.
+	UnitSystem UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
+	// Order of returned parts. Unordered if not set.
+	Sort          *PartsSort `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *ListPartsRequest) GetSort() *PartsSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// Sorting of listed parts.
+type PartsSort struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Numeric metadata key declared in the category schema.
+	// Parts without the key are returned last.
+	MetadataKey   string `protobuf:"bytes,1,opt,name=metadata_key,json=metadataKey,proto3" json:"metadata_key,omitempty"`
+	Descending    bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsSort) Reset() {
+	*x = PartsSort{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsSort) ProtoMessage() {}
+
+func (x *PartsSort) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsSort.ProtoReflect.Descriptor instead.
+func (*PartsSort) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PartsSort) GetMetadataKey() string {
+	if x != nil {
+		return x.MetadataKey
+	}
+	return ""
+}
+
+func (x *PartsSort) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// List of found Parts by filter.
+type ListPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// Request to create a part.
+type CreatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *PartInfo              `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePartRequest) GetPart() *PartInfo {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Response to create a part.
+type CreatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Request to update a part.
+type UpdatePartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Part          *PartInfo              `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetPart() *PartInfo {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Response to update a part.
+type UpdatePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// PartInfo contains writable fields of a Part.
+type PartInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PriceMinor    int64                  `protobuf:"varint,3,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"`
+	StockQuantity int64                  `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category      Category               `protobuf:"varint,5,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Dimensions in any units, stored in metric units.
+	Dimensions    *Dimensions       `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Manufacturer  *Manufacturer     `protobuf:"bytes,7,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Tags          []string          `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]*Value `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInfo) ProtoMessage() {}
+
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PartInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartInfo) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *PartInfo) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartInfo) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartInfo) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartInfo) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PartInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartInfo) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request to set a metadata schema.
+type SetMetadataSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *MetadataSchema        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataSchemaRequest) Reset() {
+	*x = SetMetadataSchemaRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *SetMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetMetadataSchemaRequest) GetSchema() *MetadataSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+// Response to set a metadata schema.
+type SetMetadataSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *MetadataSchema        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMetadataSchemaResponse) Reset() {
+	*x = SetMetadataSchemaResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *SetMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetMetadataSchemaResponse) GetSchema() *MetadataSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+// Request to get a metadata schema.
+type GetMetadataSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      Category               `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataSchemaRequest) Reset() {
+	*x = GetMetadataSchemaRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataSchemaRequest) ProtoMessage() {}
+
+func (x *GetMetadataSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMetadataSchemaRequest) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+// Response to get a metadata schema.
+type GetMetadataSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *MetadataSchema        `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataSchemaResponse) Reset() {
+	*x = GetMetadataSchemaResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataSchemaResponse) ProtoMessage() {}
+
+func (x *GetMetadataSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMetadataSchemaResponse) GetSchema() *MetadataSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+// Request to list metadata schemas.
+type ListMetadataSchemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataSchemasRequest) Reset() {
+	*x = ListMetadataSchemasRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataSchemasRequest) ProtoMessage() {}
+
+func (x *ListMetadataSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListMetadataSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+// Response to list metadata schemas.
+type ListMetadataSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*MetadataSchema      `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMetadataSchemasResponse) Reset() {
+	*x = ListMetadataSchemasResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMetadataSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMetadataSchemasResponse) ProtoMessage() {}
+
+func (x *ListMetadataSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMetadataSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListMetadataSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMetadataSchemasResponse) GetSchemas() []*MetadataSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+// MetadataSchema declares metadata keys allowed for parts of a category.
+// Parts of a category without schema accept any metadata.
+type MetadataSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      Category               `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	Fields        []*MetadataField       `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataSchema) Reset() {
+	*x = MetadataSchema{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataSchema) ProtoMessage() {}
+
+func (x *MetadataSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataSchema.ProtoReflect.Descriptor instead.
+func (*MetadataSchema) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MetadataSchema) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *MetadataSchema) GetFields() []*MetadataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// MetadataField declares a metadata key.
+type MetadataField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Expected kind of the value.
+	Kind ValueKind `protobuf:"varint,2,opt,name=kind,proto3,enum=inventory.v1.ValueKind" json:"kind,omitempty"`
+	// Whether every part of the category must have the key.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// Unit of numeric values, e.g. "kN".
+	Unit string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Inclusive bounds of numeric values.
+	Min *float64 `protobuf:"fixed64,5,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *float64 `protobuf:"fixed64,6,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// Allowed values. Any value of the kind is allowed if empty.
+	AllowedValues []*Value `protobuf:"bytes,7,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	Description   string   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataField) Reset() {
+	*x = MetadataField{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataField) ProtoMessage() {}
+
+func (x *MetadataField) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataField.ProtoReflect.Descriptor instead.
+func (*MetadataField) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MetadataField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataField) GetKind() ValueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ValueKind_VALUE_KIND_UNSPECIFIED
+}
+
+func (x *MetadataField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MetadataField) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MetadataField) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *MetadataField) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *MetadataField) GetAllowedValues() []*Value {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *MetadataField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Request to aggregate shipping info of parts.
+type GetShippingInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Parts to ship with their quantities.
+	Items []*ShippingItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Unit system of returned values. Metric if unspecified.
+	UnitSystem    UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingInfoRequest) Reset() {
+	*x = GetShippingInfoRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingInfoRequest) ProtoMessage() {}
+
+func (x *GetShippingInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetShippingInfoRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetShippingInfoRequest) GetItems() []*ShippingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetShippingInfoRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+// Aggregated shipping info of requested parts.
+type GetShippingInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShippingInfo  *ShippingInfo          `protobuf:"bytes,1,opt,name=shipping_info,json=shippingInfo,proto3" json:"shipping_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingInfoResponse) Reset() {
+	*x = GetShippingInfoResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingInfoResponse) ProtoMessage() {}
+
+func (x *GetShippingInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetShippingInfoResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetShippingInfoResponse) GetShippingInfo() *ShippingInfo {
+	if x != nil {
+		return x.ShippingInfo
+	}
+	return nil
+}
+
+// ShippingItem is a part with quantity to ship.
+type ShippingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingItem) Reset() {
+	*x = ShippingItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingItem) ProtoMessage() {}
+
+func (x *ShippingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingItem.ProtoReflect.Descriptor instead.
+func (*ShippingItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ShippingItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ShippingItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Request to change stock quantities of parts.
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*StockAdjustment     `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AdjustStockRequest) GetAdjustments() []*StockAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+// Stock quantities of adjusted parts after the change.
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         []*StockLevel          `protobuf:"bytes,1,rep,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AdjustStockResponse) GetStock() []*StockLevel {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+// StockAdjustment is a change of a part stock quantity.
+type StockAdjustment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Quantity to add, negative to take from stock.
+	Delta         int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAdjustment) Reset() {
+	*x = StockAdjustment{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustment) ProtoMessage() {}
+
+func (x *StockAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustment.ProtoReflect.Descriptor instead.
+func (*StockAdjustment) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StockAdjustment) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// StockLevel is the stock quantity of a part.
+type StockLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	StockQuantity int64                  `protobuf:"varint,2,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLevel) Reset() {
+	*x = StockLevel{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevel) ProtoMessage() {}
+
+func (x *StockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevel.ProtoReflect.Descriptor instead.
+func (*StockLevel) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StockLevel) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockLevel) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+// ShippingInfo aggregates dimensions and weight of a set of parts.
+type ShippingInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of items.
+	TotalQuantity int64 `protobuf:"varint,1,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	// Sum of actual weights.
+	TotalWeight float64 `protobuf:"fixed64,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// Sum of volumes, in cubic length_unit.
+	TotalVolume float64 `protobuf:"fixed64,3,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	// Sum of volumetric weights.
+	TotalVolumetricWeight float64 `protobuf:"fixed64,4,opt,name=total_volumetric_weight,json=totalVolumetricWeight,proto3" json:"total_volumetric_weight,omitempty"`
+	// Weight to be charged: max of actual and volumetric weight.
+	ChargeableWeight float64 `protobuf:"fixed64,5,opt,name=chargeable_weight,json=chargeableWeight,proto3" json:"chargeable_weight,omitempty"`
+	// Longest side among all parts.
+	MaxLength     float64    `protobuf:"fixed64,6,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	LengthUnit    LengthUnit `protobuf:"varint,7,opt,name=length_unit,json=lengthUnit,proto3,enum=inventory.v1.LengthUnit" json:"length_unit,omitempty"`
+	WeightUnit    WeightUnit `protobuf:"varint,8,opt,name=weight_unit,json=weightUnit,proto3,enum=inventory.v1.WeightUnit" json:"weight_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingInfo) Reset() {
+	*x = ShippingInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingInfo) ProtoMessage() {}
+
+func (x *ShippingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingInfo.ProtoReflect.Descriptor instead.
+func (*ShippingInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ShippingInfo) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetTotalWeight() float64 {
+	if x != nil {
+		return x.TotalWeight
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetTotalVolumetricWeight() float64 {
+	if x != nil {
+		return x.TotalVolumetricWeight
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetChargeableWeight() float64 {
+	if x != nil {
+		return x.ChargeableWeight
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetMaxLength() float64 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *ShippingInfo) GetLengthUnit() LengthUnit {
+	if x != nil {
+		return x.LengthUnit
+	}
+	return LengthUnit_LENGTH_UNIT_UNSPECIFIED
+}
+
+func (x *ShippingInfo) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// Request to upload an attachment.
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *UploadAttachmentInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *UploadAttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+// Info of an uploaded attachment.
+type UploadAttachmentInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the part the attachment belongs to.
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Original file name.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Expected hex-encoded SHA-256 of the content. Not checked if empty.
+	ChecksumSha256 string `protobuf:"bytes,3,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentInfo) Reset() {
+	*x = UploadAttachmentInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentInfo) ProtoMessage() {}
+
+func (x *UploadAttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentInfo.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadAttachmentInfo) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+func (x *UploadAttachmentInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
 }
 
-func (x *GetPartRequest) GetUuid() string {
+func (x *UploadAttachmentInfo) GetChecksumSha256() string {
 	if x != nil {
-		return x.Uuid
+		return x.ChecksumSha256
 	}
 	return ""
 }
 
-// Response to Get parts.
-type GetPartResponse struct {
+// Response to upload an attachment.
+type UploadAttachmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *UploadAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*UploadAttachmentResponse) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,41 +1956,44 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
 	if x != nil {
-		return x.Part
+		return x.Attachment
 	}
 	return nil
 }
 
-// Request to List parts by filter.
-type ListPartsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+// Request to download an attachment.
+type DownloadAttachmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid       string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	AttachmentUuid string                 `protobuf:"bytes,2,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	// Download the thumbnail instead of the original content.
+	Thumbnail     bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *DownloadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*DownloadAttachmentRequest) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,41 +2004,59 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *DownloadAttachmentRequest) GetPartUuid() string {
 	if x != nil {
-		return x.Filter
+		return x.PartUuid
 	}
-	return nil
+	return ""
 }
 
-// List of found Parts by filter.
-type ListPartsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+func (x *DownloadAttachmentRequest) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetThumbnail() bool {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return false
+}
+
+// Response to download an attachment.
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *DownloadAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*DownloadAttachmentResponse) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,18 +2067,52 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
 	if x != nil {
-		return x.Parts
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
 	}
 	return nil
 }
 
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
 // Part contains all general information.
 type Part struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -284,14 +2139,16 @@ type Part struct {
 	// Creation timestamp.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Images and documents attached to the part.
+	Attachments   []*Attachment `protobuf:"bytes,13,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +2160,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +2173,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Part) GetUuid() string {
@@ -403,6 +2260,120 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Attachment is an image or a document attached to a Part.
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Original file name.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Content type detected from the content.
+	ContentType string         `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Kind        AttachmentKind `protobuf:"varint,4,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// Content size in bytes.
+	SizeBytes int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Hex-encoded SHA-256 of the content.
+	ChecksumSha256 string `protobuf:"bytes,6,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// Whether a thumbnail is available. Thumbnails are PNG images.
+	HasThumbnail bool `protobuf:"varint,7,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	// Upload timestamp.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Attachment) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // Filter for details.
 // If field is empty - do not filter by this field.
 type PartsFilter struct {
@@ -412,13 +2383,15 @@ type PartsFilter struct {
 	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
 	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
 	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Ranges of numeric metadata values declared in the category schema.
+	MetadataRanges []*MetadataRange `protobuf:"bytes,6,rep,name=metadata_ranges,json=metadataRanges,proto3" json:"metadata_ranges,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +2403,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +2416,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PartsFilter) GetUuids() []string {
@@ -481,20 +2454,93 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
-// Dimenstions of the Part.
-type Dimensions struct {
+func (x *PartsFilter) GetMetadataRanges() []*MetadataRange {
+	if x != nil {
+		return x.MetadataRanges
+	}
+	return nil
+}
+
+// Inclusive range of a numeric metadata value.
+type MetadataRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
-	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
-	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min           *float64               `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *MetadataRange) Reset() {
+	*x = MetadataRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataRange) ProtoMessage() {}
+
+func (x *MetadataRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataRange.ProtoReflect.Descriptor instead.
+func (*MetadataRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MetadataRange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *MetadataRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Dimenstions of the Part.
+// Length, width and height are measured in length_unit, weight in weight_unit.
+type Dimensions struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Length     float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
+	Width      float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height     float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Weight     float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	LengthUnit LengthUnit             `protobuf:"varint,5,opt,name=length_unit,json=lengthUnit,proto3,enum=inventory.v1.LengthUnit" json:"length_unit,omitempty"`
+	WeightUnit WeightUnit             `protobuf:"varint,6,opt,name=weight_unit,json=weightUnit,proto3,enum=inventory.v1.WeightUnit" json:"weight_unit,omitempty"`
+	// Volumetric (dimensional) weight in weight_unit, output only.
+	VolumetricWeight float64 `protobuf:"fixed64,7,opt,name=volumetric_weight,json=volumetricWeight,proto3" json:"volumetric_weight,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +2552,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +2565,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -550,6 +2596,27 @@ func (x *Dimensions) GetWeight() float64 {
 	return 0
 }
 
+func (x *Dimensions) GetLengthUnit() LengthUnit {
+	if x != nil {
+		return x.LengthUnit
+	}
+	return LengthUnit_LENGTH_UNIT_UNSPECIFIED
+}
+
+func (x *Dimensions) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+func (x *Dimensions) GetVolumetricWeight() float64 {
+	if x != nil {
+		return x.VolumetricWeight
+	}
+	return 0
+}
+
 // Manufacturer of the Part.
 type Manufacturer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -562,7 +2629,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +2641,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +2654,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -627,7 +2694,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +2706,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +2719,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *Value) GetKind() isValue_Kind {
@@ -730,15 +2797,129 @@ var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"_\n" +
 	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xad\x01\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\x12+\n" +
+	"\x04sort\x18\x03 \x01(\v2\x17.inventory.v1.PartsSortR\x04sort\"N\n" +
+	"\tPartsSort\x12!\n" +
+	"\fmetadata_key\x18\x01 \x01(\tR\vmetadataKey\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending\"=\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xe0\x04\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"?\n" +
+	"\x11CreatePartRequest\x12*\n" +
+	"\x04part\x18\x01 \x01(\v2\x16.inventory.v1.PartInfoR\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"S\n" +
+	"\x11UpdatePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12*\n" +
+	"\x04part\x18\x02 \x01(\v2\x16.inventory.v1.PartInfoR\x04part\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\xde\x03\n" +
+	"\bPartInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vprice_minor\x18\x03 \x01(\x03R\n" +
+	"priceMinor\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03R\rstockQuantity\x122\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\a \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12@\n" +
+	"\bmetadata\x18\t \x03(\v2$.inventory.v1.PartInfo.MetadataEntryR\bmetadata\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"P\n" +
+	"\x18SetMetadataSchemaRequest\x124\n" +
+	"\x06schema\x18\x01 \x01(\v2\x1c.inventory.v1.MetadataSchemaR\x06schema\"Q\n" +
+	"\x19SetMetadataSchemaResponse\x124\n" +
+	"\x06schema\x18\x01 \x01(\v2\x1c.inventory.v1.MetadataSchemaR\x06schema\"N\n" +
+	"\x18GetMetadataSchemaRequest\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\"Q\n" +
+	"\x19GetMetadataSchemaResponse\x124\n" +
+	"\x06schema\x18\x01 \x01(\v2\x1c.inventory.v1.MetadataSchemaR\x06schema\"\x1c\n" +
+	"\x1aListMetadataSchemasRequest\"U\n" +
+	"\x1bListMetadataSchemasResponse\x126\n" +
+	"\aschemas\x18\x01 \x03(\v2\x1c.inventory.v1.MetadataSchemaR\aschemas\"y\n" +
+	"\x0eMetadataSchema\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x123\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x06fields\"\x9a\x02\n" +
+	"\rMetadataField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x17.inventory.v1.ValueKindR\x04kind\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x15\n" +
+	"\x03min\x18\x05 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x06 \x01(\x01H\x01R\x03max\x88\x01\x01\x12:\n" +
+	"\x0eallowed_values\x18\a \x03(\v2\x13.inventory.v1.ValueR\rallowedValues\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescriptionB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\x85\x01\n" +
+	"\x16GetShippingInfoRequest\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.inventory.v1.ShippingItemR\x05items\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\"Z\n" +
+	"\x17GetShippingInfoResponse\x12?\n" +
+	"\rshipping_info\x18\x01 \x01(\v2\x1a.inventory.v1.ShippingInfoR\fshippingInfo\"G\n" +
+	"\fShippingItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"U\n" +
+	"\x12AdjustStockRequest\x12?\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x1d.inventory.v1.StockAdjustmentR\vadjustments\"E\n" +
+	"\x13AdjustStockResponse\x12.\n" +
+	"\x05stock\x18\x01 \x03(\v2\x18.inventory.v1.StockLevelR\x05stock\"D\n" +
+	"\x0fStockAdjustment\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\"P\n" +
+	"\n" +
+	"StockLevel\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12%\n" +
+	"\x0estock_quantity\x18\x02 \x01(\x03R\rstockQuantity\"\xf5\x02\n" +
+	"\fShippingInfo\x12%\n" +
+	"\x0etotal_quantity\x18\x01 \x01(\x03R\rtotalQuantity\x12!\n" +
+	"\ftotal_weight\x18\x02 \x01(\x01R\vtotalWeight\x12!\n" +
+	"\ftotal_volume\x18\x03 \x01(\x01R\vtotalVolume\x126\n" +
+	"\x17total_volumetric_weight\x18\x04 \x01(\x01R\x15totalVolumetricWeight\x12+\n" +
+	"\x11chargeable_weight\x18\x05 \x01(\x01R\x10chargeableWeight\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x06 \x01(\x01R\tmaxLength\x129\n" +
+	"\vlength_unit\x18\a \x01(\x0e2\x18.inventory.v1.LengthUnitR\n" +
+	"lengthUnit\x129\n" +
+	"\vweight_unit\x18\b \x01(\x0e2\x18.inventory.v1.WeightUnitR\n" +
+	"weightUnit\"s\n" +
+	"\x17UploadAttachmentRequest\x128\n" +
+	"\x04info\x18\x01 \x01(\v2\".inventory.v1.UploadAttachmentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"x\n" +
+	"\x14UploadAttachmentInfo\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12'\n" +
+	"\x0fchecksum_sha256\x18\x03 \x01(\tR\x0echecksumSha256\"T\n" +
+	"\x18UploadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentR\n" +
+	"attachment\"\x7f\n" +
+	"\x19DownloadAttachmentRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12'\n" +
+	"\x0fattachment_uuid\x18\x02 \x01(\tR\x0eattachmentUuid\x12\x1c\n" +
+	"\tthumbnail\x18\x03 \x01(\bR\tthumbnail\"x\n" +
+	"\x1aDownloadAttachmentResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\x9c\x05\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -757,10 +2938,23 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\vattachments\x18\r \x03(\v2\x18.inventory.v1.AttachmentR\vattachments\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xb9\x02\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x120\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1c.inventory.v1.AttachmentKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12'\n" +
+	"\x0fchecksum_sha256\x18\x06 \x01(\tR\x0echecksumSha256\x12#\n" +
+	"\rhas_thumbnail\x18\a \x01(\bR\fhasThumbnail\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x02\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -768,13 +2962,25 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"j\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12D\n" +
+	"\x0fmetadata_ranges\x18\x06 \x03(\v2\x1b.inventory.v1.MetadataRangeR\x0emetadataRanges\"_\n" +
+	"\rMetadataRange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x15\n" +
+	"\x03min\x18\x02 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x03 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\x8d\x02\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
 	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
 	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\"V\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x129\n" +
+	"\vlength_unit\x18\x05 \x01(\x0e2\x18.inventory.v1.LengthUnitR\n" +
+	"lengthUnit\x129\n" +
+	"\vweight_unit\x18\x06 \x01(\x0e2\x18.inventory.v1.WeightUnitR\n" +
+	"weightUnit\x12+\n" +
+	"\x11volumetric_weight\x18\a \x01(\x01R\x10volumetricWeight\"V\n" +
 	"\fManufacturer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
@@ -786,16 +2992,53 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind*v\n" +
+	"\x04kind*\x80\x01\n" +
+	"\tValueKind\x12\x1a\n" +
+	"\x16VALUE_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11VALUE_KIND_STRING\x10\x01\x12\x14\n" +
+	"\x10VALUE_KIND_INT64\x10\x02\x12\x15\n" +
+	"\x11VALUE_KIND_DOUBLE\x10\x03\x12\x13\n" +
+	"\x0fVALUE_KIND_BOOL\x10\x04*j\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_IMAGE\x10\x01\x12\x1c\n" +
+	"\x18ATTACHMENT_KIND_DOCUMENT\x10\x02*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
-	"\x10InventoryService\x12F\n" +
-	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseB?Z=github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1b\x06proto3"
+	"\rCATEGORY_WING\x10\x04*[\n" +
+	"\n" +
+	"UnitSystem\x12\x1b\n" +
+	"\x17UNIT_SYSTEM_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12UNIT_SYSTEM_METRIC\x10\x01\x12\x18\n" +
+	"\x14UNIT_SYSTEM_IMPERIAL\x10\x02*[\n" +
+	"\n" +
+	"LengthUnit\x12\x1b\n" +
+	"\x17LENGTH_UNIT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16LENGTH_UNIT_CENTIMETER\x10\x01\x12\x14\n" +
+	"\x10LENGTH_UNIT_INCH\x10\x02*Z\n" +
+	"\n" +
+	"WeightUnit\x12\x1b\n" +
+	"\x17WEIGHT_UNIT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14WEIGHT_UNIT_KILOGRAM\x10\x01\x12\x15\n" +
+	"\x11WEIGHT_UNIT_POUND\x10\x022\xdf\n" +
+	"\n" +
+	"\x10InventoryService\x12d\n" +
+	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/parts/{uuid}\x12c\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/parts\x12l\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04part\"\r/api/v1/parts\x12s\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x04part\x1a\x14/api/v1/parts/{uuid}\x12\xa0\x01\n" +
+	"\x11SetMetadataSchema\x12&.inventory.v1.SetMetadataSchemaRequest\x1a'.inventory.v1.SetMetadataSchemaResponse\":\x82\xd3\xe4\x93\x024:\x06schema\x1a*/api/v1/metadata-schemas/{schema.category}\x12\x91\x01\n" +
+	"\x11GetMetadataSchema\x12&.inventory.v1.GetMetadataSchemaRequest\x1a'.inventory.v1.GetMetadataSchemaResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/metadata-schemas/{category}\x12\x8c\x01\n" +
+	"\x13ListMetadataSchemas\x12(.inventory.v1.ListMetadataSchemasRequest\x1a).inventory.v1.ListMetadataSchemasResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/metadata-schemas\x12\x86\x01\n" +
+	"\x0fGetShippingInfo\x12$.inventory.v1.GetShippingInfoRequest\x1a%.inventory.v1.GetShippingInfoResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/parts/shipping-info\x12~\n" +
+	"\vAdjustStock\x12 .inventory.v1.AdjustStockRequest\x1a!.inventory.v1.AdjustStockResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/parts/stock-adjustments\x12c\n" +
+	"\x10UploadAttachment\x12%.inventory.v1.UploadAttachmentRequest\x1a&.inventory.v1.UploadAttachmentResponse(\x01\x12i\n" +
+	"\x12DownloadAttachment\x12'.inventory.v1.DownloadAttachmentRequest\x1a(.inventory.v1.DownloadAttachmentResponse0\x01B?Z=github.com/qyrlabs/test-backend/shared/pkg/proto/inventory/v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -809,43 +3052,133 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*Part)(nil),                  // 5: inventory.v1.Part
-	(*PartsFilter)(nil),           // 6: inventory.v1.PartsFilter
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(ValueKind)(0),                      // 0: inventory.v1.ValueKind
+	(AttachmentKind)(0),                 // 1: inventory.v1.AttachmentKind
+	(Category)(0),                       // 2: inventory.v1.Category
+	(UnitSystem)(0),                     // 3: inventory.v1.UnitSystem
+	(LengthUnit)(0),                     // 4: inventory.v1.LengthUnit
+	(WeightUnit)(0),                     // 5: inventory.v1.WeightUnit
+	(*GetPartRequest)(nil),              // 6: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),             // 7: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),            // 8: inventory.v1.ListPartsRequest
+	(*PartsSort)(nil),                   // 9: inventory.v1.PartsSort
+	(*ListPartsResponse)(nil),           // 10: inventory.v1.ListPartsResponse
+	(*CreatePartRequest)(nil),           // 11: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),          // 12: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),           // 13: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),          // 14: inventory.v1.UpdatePartResponse
+	(*PartInfo)(nil),                    // 15: inventory.v1.PartInfo
+	(*SetMetadataSchemaRequest)(nil),    // 16: inventory.v1.SetMetadataSchemaRequest
+	(*SetMetadataSchemaResponse)(nil),   // 17: inventory.v1.SetMetadataSchemaResponse
+	(*GetMetadataSchemaRequest)(nil),    // 18: inventory.v1.GetMetadataSchemaRequest
+	(*GetMetadataSchemaResponse)(nil),   // 19: inventory.v1.GetMetadataSchemaResponse
+	(*ListMetadataSchemasRequest)(nil),  // 20: inventory.v1.ListMetadataSchemasRequest
+	(*ListMetadataSchemasResponse)(nil), // 21: inventory.v1.ListMetadataSchemasResponse
+	(*MetadataSchema)(nil),              // 22: inventory.v1.MetadataSchema
+	(*MetadataField)(nil),               // 23: inventory.v1.MetadataField
+	(*GetShippingInfoRequest)(nil),      // 24: inventory.v1.GetShippingInfoRequest
+	(*GetShippingInfoResponse)(nil),     // 25: inventory.v1.GetShippingInfoResponse
+	(*ShippingItem)(nil),                // 26: inventory.v1.ShippingItem
+	(*AdjustStockRequest)(nil),          // 27: inventory.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),         // 28: inventory.v1.AdjustStockResponse
+	(*StockAdjustment)(nil),             // 29: inventory.v1.StockAdjustment
+	(*StockLevel)(nil),                  // 30: inventory.v1.StockLevel
+	(*ShippingInfo)(nil),                // 31: inventory.v1.ShippingInfo
+	(*UploadAttachmentRequest)(nil),     // 32: inventory.v1.UploadAttachmentRequest
+	(*UploadAttachmentInfo)(nil),        // 33: inventory.v1.UploadAttachmentInfo
+	(*UploadAttachmentResponse)(nil),    // 34: inventory.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),   // 35: inventory.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),  // 36: inventory.v1.DownloadAttachmentResponse
+	(*Part)(nil),                        // 37: inventory.v1.Part
+	(*Attachment)(nil),                  // 38: inventory.v1.Attachment
+	(*PartsFilter)(nil),                 // 39: inventory.v1.PartsFilter
+	(*MetadataRange)(nil),               // 40: inventory.v1.MetadataRange
+	(*Dimensions)(nil),                  // 41: inventory.v1.Dimensions
+	(*Manufacturer)(nil),                // 42: inventory.v1.Manufacturer
+	(*Value)(nil),                       // 43: inventory.v1.Value
+	nil,                                 // 44: inventory.v1.PartInfo.MetadataEntry
+	nil,                                 // 45: inventory.v1.Part.MetadataEntry
+	(*timestamppb.Timestamp)(nil),       // 46: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	5,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	6,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	5,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 4: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 5: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 6: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 7: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 8: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 0: inventory.v1.GetPartRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	37, // 1: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	39, // 2: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	3,  // 3: inventory.v1.ListPartsRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	9,  // 4: inventory.v1.ListPartsRequest.sort:type_name -> inventory.v1.PartsSort
+	37, // 5: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	15, // 6: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.PartInfo
+	37, // 7: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	15, // 8: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.PartInfo
+	37, // 9: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	2,  // 10: inventory.v1.PartInfo.category:type_name -> inventory.v1.Category
+	41, // 11: inventory.v1.PartInfo.dimensions:type_name -> inventory.v1.Dimensions
+	42, // 12: inventory.v1.PartInfo.manufacturer:type_name -> inventory.v1.Manufacturer
+	44, // 13: inventory.v1.PartInfo.metadata:type_name -> inventory.v1.PartInfo.MetadataEntry
+	22, // 14: inventory.v1.SetMetadataSchemaRequest.schema:type_name -> inventory.v1.MetadataSchema
+	22, // 15: inventory.v1.SetMetadataSchemaResponse.schema:type_name -> inventory.v1.MetadataSchema
+	2,  // 16: inventory.v1.GetMetadataSchemaRequest.category:type_name -> inventory.v1.Category
+	22, // 17: inventory.v1.GetMetadataSchemaResponse.schema:type_name -> inventory.v1.MetadataSchema
+	22, // 18: inventory.v1.ListMetadataSchemasResponse.schemas:type_name -> inventory.v1.MetadataSchema
+	2,  // 19: inventory.v1.MetadataSchema.category:type_name -> inventory.v1.Category
+	23, // 20: inventory.v1.MetadataSchema.fields:type_name -> inventory.v1.MetadataField
+	0,  // 21: inventory.v1.MetadataField.kind:type_name -> inventory.v1.ValueKind
+	43, // 22: inventory.v1.MetadataField.allowed_values:type_name -> inventory.v1.Value
+	26, // 23: inventory.v1.GetShippingInfoRequest.items:type_name -> inventory.v1.ShippingItem
+	3,  // 24: inventory.v1.GetShippingInfoRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	31, // 25: inventory.v1.GetShippingInfoResponse.shipping_info:type_name -> inventory.v1.ShippingInfo
+	29, // 26: inventory.v1.AdjustStockRequest.adjustments:type_name -> inventory.v1.StockAdjustment
+	30, // 27: inventory.v1.AdjustStockResponse.stock:type_name -> inventory.v1.StockLevel
+	4,  // 28: inventory.v1.ShippingInfo.length_unit:type_name -> inventory.v1.LengthUnit
+	5,  // 29: inventory.v1.ShippingInfo.weight_unit:type_name -> inventory.v1.WeightUnit
+	33, // 30: inventory.v1.UploadAttachmentRequest.info:type_name -> inventory.v1.UploadAttachmentInfo
+	38, // 31: inventory.v1.UploadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	38, // 32: inventory.v1.DownloadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	2,  // 33: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	41, // 34: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	42, // 35: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	45, // 36: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	46, // 37: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	46, // 38: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 39: inventory.v1.Part.attachments:type_name -> inventory.v1.Attachment
+	1,  // 40: inventory.v1.Attachment.kind:type_name -> inventory.v1.AttachmentKind
+	46, // 41: inventory.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 42: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	40, // 43: inventory.v1.PartsFilter.metadata_ranges:type_name -> inventory.v1.MetadataRange
+	4,  // 44: inventory.v1.Dimensions.length_unit:type_name -> inventory.v1.LengthUnit
+	5,  // 45: inventory.v1.Dimensions.weight_unit:type_name -> inventory.v1.WeightUnit
+	43, // 46: inventory.v1.PartInfo.MetadataEntry.value:type_name -> inventory.v1.Value
+	43, // 47: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	6,  // 48: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	8,  // 49: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	11, // 50: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	13, // 51: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	16, // 52: inventory.v1.InventoryService.SetMetadataSchema:input_type -> inventory.v1.SetMetadataSchemaRequest
+	18, // 53: inventory.v1.InventoryService.GetMetadataSchema:input_type -> inventory.v1.GetMetadataSchemaRequest
+	20, // 54: inventory.v1.InventoryService.ListMetadataSchemas:input_type -> inventory.v1.ListMetadataSchemasRequest
+	24, // 55: inventory.v1.InventoryService.GetShippingInfo:input_type -> inventory.v1.GetShippingInfoRequest
+	27, // 56: inventory.v1.InventoryService.AdjustStock:input_type -> inventory.v1.AdjustStockRequest
+	32, // 57: inventory.v1.InventoryService.UploadAttachment:input_type -> inventory.v1.UploadAttachmentRequest
+	35, // 58: inventory.v1.InventoryService.DownloadAttachment:input_type -> inventory.v1.DownloadAttachmentRequest
+	7,  // 59: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	10, // 60: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	12, // 61: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	14, // 62: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	17, // 63: inventory.v1.InventoryService.SetMetadataSchema:output_type -> inventory.v1.SetMetadataSchemaResponse
+	19, // 64: inventory.v1.InventoryService.GetMetadataSchema:output_type -> inventory.v1.GetMetadataSchemaResponse
+	21, // 65: inventory.v1.InventoryService.ListMetadataSchemas:output_type -> inventory.v1.ListMetadataSchemasResponse
+	25, // 66: inventory.v1.InventoryService.GetShippingInfo:output_type -> inventory.v1.GetShippingInfoResponse
+	28, // 67: inventory.v1.InventoryService.AdjustStock:output_type -> inventory.v1.AdjustStockResponse
+	34, // 68: inventory.v1.InventoryService.UploadAttachment:output_type -> inventory.v1.UploadAttachmentResponse
+	36, // 69: inventory.v1.InventoryService.DownloadAttachment:output_type -> inventory.v1.DownloadAttachmentResponse
+	59, // [59:70] is the sub-list for method output_type
+	48, // [48:59] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -853,7 +3186,17 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[17].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[26].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[30].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[34].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[37].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -864,8 +3207,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
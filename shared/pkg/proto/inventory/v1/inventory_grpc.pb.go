@@ -19,8 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName             = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName           = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_CreatePart_FullMethodName          = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName          = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_SetMetadataSchema_FullMethodName   = "/inventory.v1.InventoryService/SetMetadataSchema"
+	InventoryService_GetMetadataSchema_FullMethodName   = "/inventory.v1.InventoryService/GetMetadataSchema"
+	InventoryService_ListMetadataSchemas_FullMethodName = "/inventory.v1.InventoryService/ListMetadataSchemas"
+	InventoryService_GetShippingInfo_FullMethodName     = "/inventory.v1.InventoryService/GetShippingInfo"
+	InventoryService_AdjustStock_FullMethodName         = "/inventory.v1.InventoryService/AdjustStock"
+	InventoryService_UploadAttachment_FullMethodName    = "/inventory.v1.InventoryService/UploadAttachment"
+	InventoryService_DownloadAttachment_FullMethodName  = "/inventory.v1.InventoryService/DownloadAttachment"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -33,6 +42,28 @@ type InventoryServiceClient interface {
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
 	// Returns List of Parts by filter.
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// Creates a part. Metadata is validated against the schema of the part category.
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// Replaces part info. Metadata is validated against the schema of the part category.
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// Defines metadata schema of a category, replacing the previous one.
+	SetMetadataSchema(ctx context.Context, in *SetMetadataSchemaRequest, opts ...grpc.CallOption) (*SetMetadataSchemaResponse, error)
+	// Returns metadata schema of a category.
+	GetMetadataSchema(ctx context.Context, in *GetMetadataSchemaRequest, opts ...grpc.CallOption) (*GetMetadataSchemaResponse, error)
+	// Returns metadata schemas of all categories.
+	ListMetadataSchemas(ctx context.Context, in *ListMetadataSchemasRequest, opts ...grpc.CallOption) (*ListMetadataSchemasResponse, error)
+	// Returns aggregated dimensions and weight of a set of parts for shipping.
+	GetShippingInfo(ctx context.Context, in *GetShippingInfoRequest, opts ...grpc.CallOption) (*GetShippingInfoResponse, error)
+	// Atomically changes stock quantities of parts. Either all adjustments are
+	// applied or none: fails with FAILED_PRECONDITION if stock of any part would
+	// become negative.
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	// Uploads an attachment of a part.
+	// The first message must carry attachment info, the following ones carry content chunks.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// Downloads an attachment or its thumbnail.
+	// The first message carries attachment info, the following ones carry content chunks.
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
 }
 
 type inventoryServiceClient struct {
@@ -63,6 +94,108 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetMetadataSchema(ctx context.Context, in *SetMetadataSchemaRequest, opts ...grpc.CallOption) (*SetMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMetadataSchema(ctx context.Context, in *GetMetadataSchemaRequest, opts ...grpc.CallOption) (*GetMetadataSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetMetadataSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListMetadataSchemas(ctx context.Context, in *ListMetadataSchemasRequest, opts ...grpc.CallOption) (*ListMetadataSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMetadataSchemasResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListMetadataSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetShippingInfo(ctx context.Context, in *GetShippingInfoRequest, opts ...grpc.CallOption) (*GetShippingInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShippingInfoResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetShippingInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *inventoryServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -73,6 +206,28 @@ type InventoryServiceServer interface {
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
 	// Returns List of Parts by filter.
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// Creates a part. Metadata is validated against the schema of the part category.
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// Replaces part info. Metadata is validated against the schema of the part category.
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// Defines metadata schema of a category, replacing the previous one.
+	SetMetadataSchema(context.Context, *SetMetadataSchemaRequest) (*SetMetadataSchemaResponse, error)
+	// Returns metadata schema of a category.
+	GetMetadataSchema(context.Context, *GetMetadataSchemaRequest) (*GetMetadataSchemaResponse, error)
+	// Returns metadata schemas of all categories.
+	ListMetadataSchemas(context.Context, *ListMetadataSchemasRequest) (*ListMetadataSchemasResponse, error)
+	// Returns aggregated dimensions and weight of a set of parts for shipping.
+	GetShippingInfo(context.Context, *GetShippingInfoRequest) (*GetShippingInfoResponse, error)
+	// Atomically changes stock quantities of parts. Either all adjustments are
+	// applied or none: fails with FAILED_PRECONDITION if stock of any part would
+	// become negative.
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	// Uploads an attachment of a part.
+	// The first message must carry attachment info, the following ones carry content chunks.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// Downloads an attachment or its thumbnail.
+	// The first message carries attachment info, the following ones carry content chunks.
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -89,6 +244,33 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetMetadataSchema(context.Context, *SetMetadataSchemaRequest) (*SetMetadataSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMetadataSchema not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMetadataSchema(context.Context, *GetMetadataSchemaRequest) (*GetMetadataSchemaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMetadataSchema not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListMetadataSchemas(context.Context, *ListMetadataSchemasRequest) (*ListMetadataSchemasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMetadataSchemas not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetShippingInfo(context.Context, *GetShippingInfoRequest) (*GetShippingInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShippingInfo not implemented")
+}
+func (UnimplementedInventoryServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedInventoryServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -146,6 +328,150 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetMetadataSchema(ctx, req.(*SetMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetMetadataSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetMetadataSchema(ctx, req.(*GetMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListMetadataSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListMetadataSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListMetadataSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListMetadataSchemas(ctx, req.(*ListMetadataSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetShippingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShippingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetShippingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetShippingInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetShippingInfo(ctx, req.(*GetShippingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _InventoryService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,7 +487,46 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "SetMetadataSchema",
+			Handler:    _InventoryService_SetMetadataSchema_Handler,
+		},
+		{
+			MethodName: "GetMetadataSchema",
+			Handler:    _InventoryService_GetMetadataSchema_Handler,
+		},
+		{
+			MethodName: "ListMetadataSchemas",
+			Handler:    _InventoryService_ListMetadataSchemas_Handler,
+		},
+		{
+			MethodName: "GetShippingInfo",
+			Handler:    _InventoryService_GetShippingInfo_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _InventoryService_AdjustStock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _InventoryService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _InventoryService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
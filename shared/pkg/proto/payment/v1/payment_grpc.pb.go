@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_PayOrder_FullMethodName = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_PayOrder_FullMethodName      = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_RefundPayment_FullMethodName = "/payment.v1.PaymentService/RefundPayment"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -28,8 +29,17 @@ const (
 //
 // PaymentService provides operations for working with payments.
 type PaymentServiceClient interface {
-	// Initiates order payment.
+	// Initiates order payment. An order, or a tender of an order paid by
+	// several tenders, is paid at most once: repeating the request returns the
+	// original transaction, while a request with another user, payment method
+	// or amount fails with ALREADY_EXISTS.
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	// Refunds a payment fully or partially. Refunds of a payment cannot
+	// exceed the paid amount, otherwise the call fails with FAILED_PRECONDITION.
+	// A refund with a refund UUID is made at most once: repeating the request
+	// returns the original refund transaction, while a request with another
+	// amount fails with ALREADY_EXISTS.
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -50,14 +60,33 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 	return out, nil
 }
 
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 //
 // PaymentService provides operations for working with payments.
 type PaymentServiceServer interface {
-	// Initiates order payment.
+	// Initiates order payment. An order, or a tender of an order paid by
+	// several tenders, is paid at most once: repeating the request returns the
+	// original transaction, while a request with another user, payment method
+	// or amount fails with ALREADY_EXISTS.
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	// Refunds a payment fully or partially. Refunds of a payment cannot
+	// exceed the paid amount, otherwise the call fails with FAILED_PRECONDITION.
+	// A refund with a refund UUID is made at most once: repeating the request
+	// returns the original refund transaction, while a request with another
+	// amount fails with ALREADY_EXISTS.
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -71,6 +100,9 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PayOrder not implemented")
 }
+func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundPayment not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -110,6 +142,24 @@ func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +171,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayOrder",
 			Handler:    _PaymentService_PayOrder_Handler,
 		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _PaymentService_RefundPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/v1/payment.proto",